@@ -0,0 +1,44 @@
+terraform {
+  required_providers {
+    controltowermanagement = {
+      source = "registry.terraform.io/eaglespirittech/controltowermanagement"
+    }
+  }
+}
+
+provider "controltowermanagement" {
+  # AWS credentials can be provided via environment variables:
+  # AWS_ACCESS_KEY
+  # AWS_SECRET_ACCESS_KEY
+  # AWS_REGION
+}
+
+# Look up an account by its exact name
+data "controltowermanagement_account" "shared_networking" {
+  name = "shared-networking"
+}
+
+# Look up an account by its root email address
+data "controltowermanagement_account" "audit" {
+  email = "aws+audit@example.com"
+}
+
+# Look up an account by its ID
+data "controltowermanagement_account" "log_archive" {
+  account_id = "123456789012"
+}
+
+output "shared_networking_account_id" {
+  description = "ID of the shared-networking account"
+  value       = data.controltowermanagement_account.shared_networking.account_id
+}
+
+output "audit_parent_path" {
+  description = "Organizational unit path of the audit account"
+  value       = data.controltowermanagement_account.audit.parent_path
+}
+
+output "log_archive_status" {
+  description = "Status of the log archive account"
+  value       = data.controltowermanagement_account.log_archive.status
+}
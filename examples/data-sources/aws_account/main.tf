@@ -32,19 +32,48 @@ output "accounts" {
 }
 
 # Example of filtering accounts by status
+data "controltowermanagement_aws_account" "active" {
+  status = "ACTIVE"
+}
+
 output "active_accounts" {
   description = "List of active AWS accounts"
-  value = [
-    for account in data.controltowermanagement_aws_account.example.accounts :
-    account if account.status == "ACTIVE"
-  ]
+  value       = data.controltowermanagement_aws_account.active.accounts
 }
 
 # Example of finding a specific account by email
+data "controltowermanagement_aws_account" "admin" {
+  email_regex = "^admin@example\\.com$"
+}
+
 output "specific_account" {
   description = "Details of a specific account"
-  value = [
+  value       = data.controltowermanagement_aws_account.admin.accounts
+}
+
+# Example of combining filters: active production accounts anywhere below an
+# organizational unit
+data "controltowermanagement_aws_account" "production" {
+  status     = "ACTIVE"
+  name_regex = "^prod-"
+  parent_id  = "ou-abcd-12345678"
+  recursive  = true
+
+  tags = {
+    environment = "production"
+  }
+}
+
+output "production_accounts" {
+  description = "List of active production AWS accounts"
+  value       = data.controltowermanagement_aws_account.production.accounts
+}
+
+# Example of an inventory report grouped by organizational unit
+output "accounts_by_parent_path" {
+  description = "Account IDs grouped by the path of their parent organizational unit"
+  value = {
     for account in data.controltowermanagement_aws_account.example.accounts :
-    account if account.email == "admin@example.com"
-  ]
-}
\ No newline at end of file
+    account.parent_path => account.account_id...
+  }
+}
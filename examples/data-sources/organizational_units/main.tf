@@ -0,0 +1,44 @@
+terraform {
+  required_providers {
+    controltowermanagement = {
+      source = "registry.terraform.io/eaglespirittech/controltowermanagement"
+    }
+  }
+}
+
+provider "controltowermanagement" {
+  # AWS credentials can be provided via environment variables:
+  # AWS_ACCESS_KEY
+  # AWS_SECRET_ACCESS_KEY
+  # AWS_REGION
+}
+
+# Walk the whole organizational unit tree
+data "controltowermanagement_organizational_units" "all" {}
+
+locals {
+  # Look organizational units up by path instead of hardcoding IDs
+  ou_ids_by_path = {
+    for ou in data.controltowermanagement_organizational_units.all.organizational_units :
+    ou.path => ou.id
+  }
+}
+
+output "root_id" {
+  description = "ID of the organization root"
+  value       = data.controltowermanagement_organizational_units.all.root_id
+}
+
+output "prod_ou_id" {
+  description = "ID of the Root/Workloads/Prod organizational unit"
+  value       = lookup(local.ou_ids_by_path, "Root/Workloads/Prod", null)
+}
+
+# Example of listing only the top-level organizational units
+output "top_level_ous" {
+  description = "Names of organizational units directly under the root"
+  value = [
+    for ou in data.controltowermanagement_organizational_units.all.organizational_units :
+    ou.name if ou.depth == 1
+  ]
+}
@@ -0,0 +1,36 @@
+terraform {
+  required_providers {
+    controltowermanagement = {
+      source = "registry.terraform.io/eaglespirittech/controltowermanagement"
+    }
+  }
+}
+
+provider "controltowermanagement" {
+  # AWS credentials can be provided via environment variables:
+  # AWS_ACCESS_KEY
+  # AWS_SECRET_ACCESS_KEY
+  # AWS_REGION
+}
+
+# Vend a new account through Control Tower Account Factory
+resource "controltowermanagement_account" "example" {
+  account_name  = "workload-prod"
+  account_email = "aws+workload-prod@example.com"
+
+  sso_user_email      = "platform-team@example.com"
+  sso_user_first_name = "Platform"
+  sso_user_last_name  = "Team"
+
+  # Changing the OU moves the account in place
+  managed_organizational_unit = "Workloads (ou-abcd-12345678)"
+
+  # Only terminate the Account Factory provisioned product on destroy and
+  # keep the account in the organization
+  close_account_on_delete = false
+}
+
+output "account_id" {
+  description = "ID of the vended account"
+  value       = controltowermanagement_account.example.account_id
+}
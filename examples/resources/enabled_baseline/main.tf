@@ -0,0 +1,48 @@
+terraform {
+  required_providers {
+    controltowermanagement = {
+      source = "registry.terraform.io/eaglespirittech/controltowermanagement"
+    }
+  }
+}
+
+provider "controltowermanagement" {
+  # AWS credentials can be provided via environment variables:
+  # AWS_ACCESS_KEY
+  # AWS_SECRET_ACCESS_KEY
+  # AWS_REGION
+}
+
+variable "target_ou_arn" {
+  description = "ARN of the organizational unit to register with Control Tower"
+  type        = string
+}
+
+variable "control_tower_baseline_arn" {
+  description = "ARN of the AWSControlTowerBaseline baseline in the home region"
+  type        = string
+}
+
+variable "identity_center_enabled_baseline_arn" {
+  description = "ARN of the enabled IdentityCenterBaseline on the landing zone"
+  type        = string
+}
+
+# Register the organizational unit with Control Tower
+resource "controltowermanagement_enabled_baseline" "example" {
+  baseline_identifier = var.control_tower_baseline_arn
+  baseline_version    = "4.0"
+  target_identifier   = var.target_ou_arn
+
+  parameters = {
+    IdentityCenterEnabledBaselineArn = jsonencode(var.identity_center_enabled_baseline_arn)
+  }
+
+  # Re-apply the baseline if a previous operation left it in a FAILED state
+  reset_on_failure = true
+}
+
+output "enabled_baseline_arn" {
+  description = "ARN of the enabled baseline"
+  value       = controltowermanagement_enabled_baseline.example.arn
+}
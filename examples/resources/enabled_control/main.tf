@@ -0,0 +1,59 @@
+terraform {
+  required_providers {
+    controltowermanagement = {
+      source = "registry.terraform.io/eaglespirittech/controltowermanagement"
+    }
+  }
+}
+
+provider "controltowermanagement" {
+  # AWS credentials can be provided via environment variables:
+  # AWS_ACCESS_KEY
+  # AWS_SECRET_ACCESS_KEY
+  # AWS_REGION
+}
+
+variable "target_ou_arn" {
+  description = "ARN of the organizational unit on which to enable the controls"
+  type        = string
+}
+
+variable "region" {
+  description = "Home region of the landing zone"
+  type        = string
+  default     = "us-west-2"
+}
+
+variable "controls" {
+  description = "Control identifiers to enable on the organizational unit"
+  type        = list(string)
+  default = [
+    "AWS-GR_RESTRICT_ROOT_USER_ACCESS_KEYS",
+    "AWS-GR_ENCRYPTED_VOLUMES",
+  ]
+}
+
+# Enable each control on the organizational unit
+resource "controltowermanagement_enabled_control" "example" {
+  for_each = toset(var.controls)
+
+  control_identifier = "arn:aws:controltower:${var.region}::control/${each.value}"
+  target_identifier  = var.target_ou_arn
+}
+
+# Enable the region deny control with parameters; changing the parameters
+# updates the control in place instead of disabling and re-enabling it
+resource "controltowermanagement_enabled_control" "region_deny" {
+  control_identifier = "arn:aws:controltower:${var.region}::control/CT.MULTISERVICE.PV.1"
+  target_identifier  = var.target_ou_arn
+
+  parameters = {
+    AllowedRegions        = jsonencode(["us-east-1", "us-west-2"])
+    ExemptedPrincipalArns = jsonencode(["arn:aws:iam::*:role/AWSControlTowerExecution"])
+  }
+}
+
+output "enabled_control_arns" {
+  description = "ARNs of the enabled controls"
+  value       = { for k, v in controltowermanagement_enabled_control.example : k => v.arn }
+}
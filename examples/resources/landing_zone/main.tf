@@ -0,0 +1,33 @@
+terraform {
+  required_providers {
+    controltowermanagement = {
+      source = "registry.terraform.io/eaglespirittech/controltowermanagement"
+    }
+  }
+}
+
+provider "controltowermanagement" {
+  # AWS credentials can be provided via environment variables:
+  # AWS_ACCESS_KEY
+  # AWS_SECRET_ACCESS_KEY
+  # AWS_REGION
+}
+
+# Manage the Control Tower landing zone from a manifest file
+resource "controltowermanagement_landing_zone" "example" {
+  version       = "3.3"
+  manifest_json = file("${path.module}/manifest.json")
+
+  # Reset the landing zone when Control Tower reports drift
+  reset_on_drift = true
+}
+
+output "landing_zone_arn" {
+  description = "ARN of the landing zone"
+  value       = controltowermanagement_landing_zone.example.arn
+}
+
+output "landing_zone_drift_status" {
+  description = "Drift status of the landing zone"
+  value       = controltowermanagement_landing_zone.example.drift_status
+}
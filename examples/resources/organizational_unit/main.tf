@@ -0,0 +1,41 @@
+terraform {
+  required_providers {
+    controltowermanagement = {
+      source = "registry.terraform.io/eaglespirittech/controltowermanagement"
+    }
+  }
+}
+
+provider "controltowermanagement" {
+  # AWS credentials can be provided via environment variables:
+  # AWS_ACCESS_KEY
+  # AWS_SECRET_ACCESS_KEY
+  # AWS_REGION
+}
+
+variable "root_id" {
+  description = "ID of the organization root (e.g., r-abcd)"
+  type        = string
+}
+
+# Top-level organizational unit for workload accounts
+resource "controltowermanagement_organizational_unit" "workloads" {
+  name      = "Workloads"
+  parent_id = var.root_id
+}
+
+# Nested organizational units; renaming them updates in place
+resource "controltowermanagement_organizational_unit" "prod" {
+  name      = "Prod"
+  parent_id = controltowermanagement_organizational_unit.workloads.id
+}
+
+resource "controltowermanagement_organizational_unit" "dev" {
+  name      = "Dev"
+  parent_id = controltowermanagement_organizational_unit.workloads.id
+}
+
+output "workloads_ou_id" {
+  description = "ID of the Workloads organizational unit"
+  value       = controltowermanagement_organizational_unit.workloads.id
+}
@@ -0,0 +1,327 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/servicecatalog"
+	scTypes "github.com/aws/aws-sdk-go-v2/service/servicecatalog/types"
+)
+
+// AccountFactoryProductName is the name of the Service Catalog product that
+// Control Tower Account Factory uses to vend accounts
+const AccountFactoryProductName = "AWS Control Tower Account Factory"
+
+// AccountFactoryInput represents the provisioning parameters of an Account Factory account
+type AccountFactoryInput struct {
+	AccountName               string
+	AccountEmail              string
+	SSOUserEmail              string
+	SSOUserFirstName          string
+	SSOUserLastName           string
+	ManagedOrganizationalUnit string
+}
+
+// ProvisionedAccountInfo represents an account provisioned through Account Factory
+type ProvisionedAccountInfo struct {
+	ProvisionedProductId   string
+	ProvisionedProductName string
+	Status                 string
+	AccountId              string
+	AccountEmail           string
+	// Parameters are the Account Factory parameters the account was last
+	// provisioned with, or nil when its CloudFormation stack is unknown
+	Parameters *AccountFactoryInput
+}
+
+// cloudFormationStackOutputKey is the provisioned product output holding the
+// ARN of the CloudFormation stack behind it
+const cloudFormationStackOutputKey = "CloudformationStackARN"
+
+// provisioningParameters returns the Account Factory product parameters
+func (i *AccountFactoryInput) provisioningParameters() map[string]string {
+	return map[string]string{
+		"AccountName":               i.AccountName,
+		"AccountEmail":              i.AccountEmail,
+		"SSOUserEmail":              i.SSOUserEmail,
+		"SSOUserFirstName":          i.SSOUserFirstName,
+		"SSOUserLastName":           i.SSOUserLastName,
+		"ManagedOrganizationalUnit": i.ManagedOrganizationalUnit,
+	}
+}
+
+// serviceCatalog returns the Service Catalog client, creating one from the
+// current AWS configuration when no client has been injected
+func (c *Client) serviceCatalog() ServiceCatalogAPI {
+	if c.scClient != nil {
+		return c.scClient
+	}
+	return servicecatalog.NewFromConfig(c.awsConfig, func(o *servicecatalog.Options) {
+		if c.endpoints.ServiceCatalog != "" {
+			o.BaseEndpoint = aws.String(c.endpoints.ServiceCatalog)
+		}
+		o.APIOptions = append(o.APIOptions, c.limiters.serviceCatalog.addMiddleware, addTraceMiddleware)
+	})
+}
+
+// cloudFormation returns the CloudFormation client, creating one from the
+// current AWS configuration when no client has been injected
+func (c *Client) cloudFormation() CloudFormationAPI {
+	if c.cfClient != nil {
+		return c.cfClient
+	}
+	return cloudformation.NewFromConfig(c.awsConfig, func(o *cloudformation.Options) {
+		if c.endpoints.CloudFormation != "" {
+			o.BaseEndpoint = aws.String(c.endpoints.CloudFormation)
+		}
+		o.APIOptions = append(o.APIOptions, c.limiters.cloudFormation.addMiddleware, addTraceMiddleware)
+	})
+}
+
+// accountFactoryProduct resolves the Account Factory product ID and its
+// current provisioning artifact ID
+func (c *Client) accountFactoryProduct(ctx context.Context) (string, string, error) {
+	result, err := c.serviceCatalog().DescribeProduct(ctx, &servicecatalog.DescribeProductInput{
+		Name: aws.String(AccountFactoryProductName),
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("failed to describe %s product: %w", AccountFactoryProductName, err)
+	}
+	if result.ProductViewSummary == nil {
+		return "", "", fmt.Errorf("failed to describe %s product: empty response", AccountFactoryProductName)
+	}
+
+	// Use the most recently created artifact that has not been deprecated
+	var artifactID string
+	var artifactCreated time.Time
+	for _, artifact := range result.ProvisioningArtifacts {
+		if artifact.Guidance == scTypes.ProvisioningArtifactGuidanceDeprecated {
+			continue
+		}
+		if artifactID == "" || aws.ToTime(artifact.CreatedTime).After(artifactCreated) {
+			artifactID = aws.ToString(artifact.Id)
+			artifactCreated = aws.ToTime(artifact.CreatedTime)
+		}
+	}
+	if artifactID == "" {
+		return "", "", fmt.Errorf("no active provisioning artifact found for %s product", AccountFactoryProductName)
+	}
+
+	return aws.ToString(result.ProductViewSummary.ProductId), artifactID, nil
+}
+
+// ProvisionAccount starts vending a new account through Account Factory and
+// returns the provisioned product ID and the provisioning record ID
+func (c *Client) ProvisionAccount(ctx context.Context, provisionedProductName string, input *AccountFactoryInput) (string, string, error) {
+	productID, artifactID, err := c.accountFactoryProduct(ctx)
+	if err != nil {
+		return "", "", err
+	}
+
+	parameters := input.provisioningParameters()
+	provisioningParameters := make([]scTypes.ProvisioningParameter, 0, len(parameters))
+	for key, value := range parameters {
+		provisioningParameters = append(provisioningParameters, scTypes.ProvisioningParameter{
+			Key:   aws.String(key),
+			Value: aws.String(value),
+		})
+	}
+
+	result, err := c.serviceCatalog().ProvisionProduct(ctx, &servicecatalog.ProvisionProductInput{
+		ProductId:              aws.String(productID),
+		ProvisioningArtifactId: aws.String(artifactID),
+		ProvisionedProductName: aws.String(provisionedProductName),
+		ProvisioningParameters: provisioningParameters,
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("failed to provision account %s: %w", input.AccountName, err)
+	}
+	if result.RecordDetail == nil {
+		return "", "", fmt.Errorf("failed to provision account %s: empty response", input.AccountName)
+	}
+
+	return aws.ToString(result.RecordDetail.ProvisionedProductId), aws.ToString(result.RecordDetail.RecordId), nil
+}
+
+// UpdateProvisionedAccount starts updating an Account Factory account, for
+// example to rename it or move it to another organizational unit, and returns
+// the provisioning record ID
+func (c *Client) UpdateProvisionedAccount(ctx context.Context, provisionedProductID string, input *AccountFactoryInput) (string, error) {
+	productID, artifactID, err := c.accountFactoryProduct(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	parameters := input.provisioningParameters()
+	provisioningParameters := make([]scTypes.UpdateProvisioningParameter, 0, len(parameters))
+	for key, value := range parameters {
+		provisioningParameters = append(provisioningParameters, scTypes.UpdateProvisioningParameter{
+			Key:   aws.String(key),
+			Value: aws.String(value),
+		})
+	}
+
+	result, err := c.serviceCatalog().UpdateProvisionedProduct(ctx, &servicecatalog.UpdateProvisionedProductInput{
+		ProvisionedProductId:   aws.String(provisionedProductID),
+		ProductId:              aws.String(productID),
+		ProvisioningArtifactId: aws.String(artifactID),
+		ProvisioningParameters: provisioningParameters,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to update provisioned account %s: %w", provisionedProductID, err)
+	}
+	if result.RecordDetail == nil {
+		return "", fmt.Errorf("failed to update provisioned account %s: empty response", provisionedProductID)
+	}
+
+	return aws.ToString(result.RecordDetail.RecordId), nil
+}
+
+// TerminateProvisionedAccount starts terminating the Account Factory
+// provisioned product and returns the provisioning record ID. The account
+// itself stays in the organization; use CloseAccount to close it.
+func (c *Client) TerminateProvisionedAccount(ctx context.Context, provisionedProductID string) (string, error) {
+	result, err := c.serviceCatalog().TerminateProvisionedProduct(ctx, &servicecatalog.TerminateProvisionedProductInput{
+		ProvisionedProductId: aws.String(provisionedProductID),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to terminate provisioned account %s: %w", provisionedProductID, err)
+	}
+	if result.RecordDetail == nil {
+		return "", fmt.Errorf("failed to terminate provisioned account %s: empty response", provisionedProductID)
+	}
+
+	return aws.ToString(result.RecordDetail.RecordId), nil
+}
+
+// WaitForProvisioningRecord blocks until the given Service Catalog
+// provisioning record succeeds or fails
+func (c *Client) WaitForProvisioningRecord(ctx context.Context, recordID string) error {
+	return c.waitForOperation(ctx, recordID, func(ctx context.Context) (string, string, error) {
+		result, err := c.serviceCatalog().DescribeRecord(ctx, &servicecatalog.DescribeRecordInput{
+			Id: aws.String(recordID),
+		})
+		if err != nil {
+			return "", "", err
+		}
+		if result.RecordDetail == nil {
+			return "", "", fmt.Errorf("empty record details")
+		}
+
+		switch result.RecordDetail.Status {
+		case scTypes.RecordStatusSucceeded:
+			return operationStatusSucceeded, "", nil
+		case scTypes.RecordStatusFailed, scTypes.RecordStatusInProgressInError:
+			messages := make([]string, 0, len(result.RecordDetail.RecordErrors))
+			for _, recordError := range result.RecordDetail.RecordErrors {
+				messages = append(messages, fmt.Sprintf("%s: %s", aws.ToString(recordError.Code), aws.ToString(recordError.Description)))
+			}
+			return operationStatusFailed, strings.Join(messages, "; "), nil
+		default:
+			return string(result.RecordDetail.Status), "", nil
+		}
+	})
+}
+
+// GetProvisionedAccount retrieves an Account Factory provisioned product and
+// the ID and email of the account it vended
+func (c *Client) GetProvisionedAccount(ctx context.Context, provisionedProductID string) (*ProvisionedAccountInfo, error) {
+	result, err := c.serviceCatalog().DescribeProvisionedProduct(ctx, &servicecatalog.DescribeProvisionedProductInput{
+		Id: aws.String(provisionedProductID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe provisioned account %s: %w", provisionedProductID, err)
+	}
+
+	detail := result.ProvisionedProductDetail
+	if detail == nil {
+		return nil, fmt.Errorf("failed to describe provisioned account %s: empty response", provisionedProductID)
+	}
+
+	info := &ProvisionedAccountInfo{
+		ProvisionedProductId:   aws.ToString(detail.Id),
+		ProvisionedProductName: aws.ToString(detail.Name),
+		Status:                 string(detail.Status),
+	}
+
+	outputs, err := c.serviceCatalog().GetProvisionedProductOutputs(ctx, &servicecatalog.GetProvisionedProductOutputsInput{
+		ProvisionedProductId: aws.String(provisionedProductID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get outputs of provisioned account %s: %w", provisionedProductID, err)
+	}
+
+	var stackARN string
+	for _, output := range outputs.Outputs {
+		switch aws.ToString(output.OutputKey) {
+		case "AccountId":
+			info.AccountId = aws.ToString(output.OutputValue)
+		case "AccountEmail":
+			info.AccountEmail = aws.ToString(output.OutputValue)
+		case cloudFormationStackOutputKey:
+			stackARN = aws.ToString(output.OutputValue)
+		}
+	}
+
+	if stackARN == "" && detail.LastSuccessfulProvisioningRecordId != nil {
+		record, err := c.serviceCatalog().DescribeRecord(ctx, &servicecatalog.DescribeRecordInput{
+			Id: detail.LastSuccessfulProvisioningRecordId,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe provisioning record of account %s: %w", provisionedProductID, err)
+		}
+		for _, output := range record.RecordOutputs {
+			if aws.ToString(output.OutputKey) == cloudFormationStackOutputKey {
+				stackARN = aws.ToString(output.OutputValue)
+			}
+		}
+	}
+
+	if stackARN != "" {
+		info.Parameters, err = c.accountFactoryParameters(ctx, stackARN)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get parameters of provisioned account %s: %w", provisionedProductID, err)
+		}
+	}
+
+	return info, nil
+}
+
+// accountFactoryParameters reads the Account Factory parameters from the
+// CloudFormation stack of a provisioned product
+func (c *Client) accountFactoryParameters(ctx context.Context, stackARN string) (*AccountFactoryInput, error) {
+	result, err := c.cloudFormation().DescribeStacks(ctx, &cloudformation.DescribeStacksInput{
+		StackName: aws.String(stackARN),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe stack %s: %w", stackARN, err)
+	}
+	if len(result.Stacks) == 0 {
+		return nil, fmt.Errorf("failed to describe stack %s: empty response", stackARN)
+	}
+
+	input := &AccountFactoryInput{}
+	for _, parameter := range result.Stacks[0].Parameters {
+		value := aws.ToString(parameter.ParameterValue)
+		switch aws.ToString(parameter.ParameterKey) {
+		case "AccountName":
+			input.AccountName = value
+		case "AccountEmail":
+			input.AccountEmail = value
+		case "SSOUserEmail":
+			input.SSOUserEmail = value
+		case "SSOUserFirstName":
+			input.SSOUserFirstName = value
+		case "SSOUserLastName":
+			input.SSOUserLastName = value
+		case "ManagedOrganizationalUnit":
+			input.ManagedOrganizationalUnit = value
+		}
+	}
+
+	return input, nil
+}
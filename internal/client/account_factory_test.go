@@ -0,0 +1,190 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cfTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/servicecatalog"
+	scTypes "github.com/aws/aws-sdk-go-v2/service/servicecatalog/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func testAccountFactoryProduct(mockSC *MockServiceCatalogAPI) {
+	mockSC.On("DescribeProduct", mock.Anything, mock.MatchedBy(func(input *servicecatalog.DescribeProductInput) bool {
+		return aws.ToString(input.Name) == AccountFactoryProductName
+	})).Return(&servicecatalog.DescribeProductOutput{
+		ProductViewSummary: &scTypes.ProductViewSummary{ProductId: aws.String("prod-abc")},
+		ProvisioningArtifacts: []scTypes.ProvisioningArtifact{
+			{Id: aws.String("pa-current"), Guidance: scTypes.ProvisioningArtifactGuidanceDefault, CreatedTime: aws.Time(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))},
+			{Id: aws.String("pa-previous"), Guidance: scTypes.ProvisioningArtifactGuidanceDefault, CreatedTime: aws.Time(time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC))},
+			{Id: aws.String("pa-deprecated"), Guidance: scTypes.ProvisioningArtifactGuidanceDeprecated, CreatedTime: aws.Time(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC))},
+		},
+	}, nil)
+}
+
+func TestProvisionAccount(t *testing.T) {
+	mockSC := new(MockServiceCatalogAPI)
+	testAccountFactoryProduct(mockSC)
+
+	mockSC.On("ProvisionProduct", mock.Anything, mock.MatchedBy(func(input *servicecatalog.ProvisionProductInput) bool {
+		parameters := map[string]string{}
+		for _, parameter := range input.ProvisioningParameters {
+			parameters[aws.ToString(parameter.Key)] = aws.ToString(parameter.Value)
+		}
+		return aws.ToString(input.ProductId) == "prod-abc" &&
+			aws.ToString(input.ProvisioningArtifactId) == "pa-current" &&
+			aws.ToString(input.ProvisionedProductName) == "workload-prod" &&
+			parameters["AccountName"] == "workload-prod" &&
+			parameters["ManagedOrganizationalUnit"] == "Workloads (ou-abcd-12345678)"
+	})).Return(&servicecatalog.ProvisionProductOutput{
+		RecordDetail: &scTypes.RecordDetail{
+			ProvisionedProductId: aws.String("pp-123"),
+			RecordId:             aws.String("rec-1"),
+		},
+	}, nil)
+
+	testClient := &Client{scClient: mockSC}
+
+	provisionedProductID, recordID, err := testClient.ProvisionAccount(context.Background(), "workload-prod", &AccountFactoryInput{
+		AccountName:               "workload-prod",
+		AccountEmail:              "workload-prod@example.com",
+		SSOUserEmail:              "admin@example.com",
+		SSOUserFirstName:          "Admin",
+		SSOUserLastName:           "User",
+		ManagedOrganizationalUnit: "Workloads (ou-abcd-12345678)",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "pp-123", provisionedProductID)
+	assert.Equal(t, "rec-1", recordID)
+	mockSC.AssertExpectations(t)
+}
+
+func TestWaitForProvisioningRecordFailed(t *testing.T) {
+	mockSC := new(MockServiceCatalogAPI)
+
+	mockSC.On("DescribeRecord", mock.Anything, mock.Anything).
+		Return(&servicecatalog.DescribeRecordOutput{
+			RecordDetail: &scTypes.RecordDetail{Status: scTypes.RecordStatusInProgress},
+		}, nil).Once()
+	mockSC.On("DescribeRecord", mock.Anything, mock.Anything).
+		Return(&servicecatalog.DescribeRecordOutput{
+			RecordDetail: &scTypes.RecordDetail{
+				Status: scTypes.RecordStatusInProgressInError,
+				RecordErrors: []scTypes.RecordError{
+					{Code: aws.String("ProvisioningFailed"), Description: aws.String("Email address is already in use")},
+				},
+			},
+		}, nil).Once()
+
+	testClient := &Client{scClient: mockSC, operationPollInterval: time.Millisecond}
+
+	err := testClient.WaitForProvisioningRecord(context.Background(), "rec-1")
+	var opErr *OperationFailedError
+	assert.True(t, errors.As(err, &opErr))
+	assert.Equal(t, "ProvisioningFailed: Email address is already in use", opErr.StatusMessage)
+}
+
+func TestGetProvisionedAccount(t *testing.T) {
+	mockSC := new(MockServiceCatalogAPI)
+
+	mockSC.On("DescribeProvisionedProduct", mock.Anything, mock.Anything).
+		Return(&servicecatalog.DescribeProvisionedProductOutput{
+			ProvisionedProductDetail: &scTypes.ProvisionedProductDetail{
+				Id:     aws.String("pp-123"),
+				Name:   aws.String("workload-prod"),
+				Status: scTypes.ProvisionedProductStatusAvailable,
+			},
+		}, nil)
+	mockSC.On("GetProvisionedProductOutputs", mock.Anything, mock.Anything).
+		Return(&servicecatalog.GetProvisionedProductOutputsOutput{
+			Outputs: []scTypes.RecordOutput{
+				{OutputKey: aws.String("AccountId"), OutputValue: aws.String("123456789012")},
+				{OutputKey: aws.String("AccountEmail"), OutputValue: aws.String("workload-prod@example.com")},
+			},
+		}, nil)
+
+	testClient := &Client{scClient: mockSC}
+
+	info, err := testClient.GetProvisionedAccount(context.Background(), "pp-123")
+	assert.NoError(t, err)
+	assert.Equal(t, "123456789012", info.AccountId)
+	assert.Equal(t, "workload-prod@example.com", info.AccountEmail)
+	assert.Equal(t, "AVAILABLE", info.Status)
+	assert.Nil(t, info.Parameters)
+}
+
+func TestGetProvisionedAccountParameters(t *testing.T) {
+	stackARN := "arn:aws:cloudformation:us-east-1:111111111111:stack/SC-111111111111-pp-123/abc"
+	mockSC := new(MockServiceCatalogAPI)
+	mockCF := new(MockCloudFormationAPI)
+
+	mockSC.On("DescribeProvisionedProduct", mock.Anything, mock.Anything).
+		Return(&servicecatalog.DescribeProvisionedProductOutput{
+			ProvisionedProductDetail: &scTypes.ProvisionedProductDetail{
+				Id:                                 aws.String("pp-123"),
+				Name:                               aws.String("workload-prod"),
+				Status:                             scTypes.ProvisionedProductStatusAvailable,
+				LastSuccessfulProvisioningRecordId: aws.String("rec-1"),
+			},
+		}, nil)
+	mockSC.On("GetProvisionedProductOutputs", mock.Anything, mock.Anything).
+		Return(&servicecatalog.GetProvisionedProductOutputsOutput{
+			Outputs: []scTypes.RecordOutput{
+				{OutputKey: aws.String("AccountId"), OutputValue: aws.String("123456789012")},
+			},
+		}, nil)
+	mockSC.On("DescribeRecord", mock.Anything, mock.MatchedBy(func(input *servicecatalog.DescribeRecordInput) bool {
+		return aws.ToString(input.Id) == "rec-1"
+	})).Return(&servicecatalog.DescribeRecordOutput{
+		RecordOutputs: []scTypes.RecordOutput{
+			{OutputKey: aws.String("CloudformationStackARN"), OutputValue: aws.String(stackARN)},
+		},
+	}, nil)
+	mockCF.On("DescribeStacks", mock.Anything, mock.MatchedBy(func(input *cloudformation.DescribeStacksInput) bool {
+		return aws.ToString(input.StackName) == stackARN
+	})).Return(&cloudformation.DescribeStacksOutput{
+		Stacks: []cfTypes.Stack{{
+			Parameters: []cfTypes.Parameter{
+				{ParameterKey: aws.String("AccountName"), ParameterValue: aws.String("workload-prod")},
+				{ParameterKey: aws.String("AccountEmail"), ParameterValue: aws.String("workload-prod@example.com")},
+				{ParameterKey: aws.String("SSOUserEmail"), ParameterValue: aws.String("admin@example.com")},
+				{ParameterKey: aws.String("SSOUserFirstName"), ParameterValue: aws.String("Admin")},
+				{ParameterKey: aws.String("SSOUserLastName"), ParameterValue: aws.String("User")},
+				{ParameterKey: aws.String("ManagedOrganizationalUnit"), ParameterValue: aws.String("Workloads (ou-abcd-12345678)")},
+			},
+		}},
+	}, nil)
+
+	testClient := &Client{scClient: mockSC, cfClient: mockCF}
+
+	info, err := testClient.GetProvisionedAccount(context.Background(), "pp-123")
+	assert.NoError(t, err)
+	assert.Equal(t, &AccountFactoryInput{
+		AccountName:               "workload-prod",
+		AccountEmail:              "workload-prod@example.com",
+		SSOUserEmail:              "admin@example.com",
+		SSOUserFirstName:          "Admin",
+		SSOUserLastName:           "User",
+		ManagedOrganizationalUnit: "Workloads (ou-abcd-12345678)",
+	}, info.Parameters)
+	mockSC.AssertExpectations(t)
+	mockCF.AssertExpectations(t)
+}
+
+func TestGetProvisionedAccountNotFound(t *testing.T) {
+	mockSC := new(MockServiceCatalogAPI)
+
+	mockSC.On("DescribeProvisionedProduct", mock.Anything, mock.Anything).
+		Return(nil, &scTypes.ResourceNotFoundException{Message: aws.String("not found")})
+
+	testClient := &Client{scClient: mockSC}
+
+	_, err := testClient.GetProvisionedAccount(context.Background(), "pp-missing")
+	assert.True(t, IsNotFound(err))
+}
@@ -0,0 +1,178 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+)
+
+// AccountFilter narrows down the accounts returned by FindAccounts. Zero
+// values match every account.
+type AccountFilter struct {
+	Status     string
+	NameRegex  *regexp.Regexp
+	EmailRegex *regexp.Regexp
+	// ParentId limits the result to accounts directly under this root or
+	// organizational unit, or anywhere below it when Recursive is set
+	ParentId  string
+	Recursive bool
+	// Tags limits the result to accounts that carry every given tag
+	Tags map[string]string
+}
+
+// FindAccounts returns the accounts in the organization that match filter.
+// The cheap attribute filters are applied before tags are fetched, so tags
+// are only looked up for the remaining candidates.
+func (c *Client) FindAccounts(ctx context.Context, filter *AccountFilter) ([]AccountInfo, error) {
+	var candidates []AccountInfo
+	var err error
+	if filter.ParentId != "" {
+		candidates, err = c.listAccountsUnderParent(ctx, filter.ParentId, filter.Recursive)
+	} else {
+		candidates, err = c.GetAccountInfo(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var accounts []AccountInfo
+	for _, account := range candidates {
+		if filter.Status != "" && account.Status != filter.Status {
+			continue
+		}
+		if filter.NameRegex != nil && !filter.NameRegex.MatchString(account.AccountName) {
+			continue
+		}
+		if filter.EmailRegex != nil && !filter.EmailRegex.MatchString(account.Email) {
+			continue
+		}
+
+		if len(filter.Tags) > 0 {
+			tags, err := c.GetAccountTags(ctx, account.AccountId)
+			if err != nil {
+				return nil, err
+			}
+			if !hasTags(tags, filter.Tags) {
+				continue
+			}
+		}
+
+		accounts = append(accounts, account)
+	}
+
+	return accounts, nil
+}
+
+// FindAccount returns the single account that matches filter, or an error
+// when no account or more than one account matches
+func (c *Client) FindAccount(ctx context.Context, filter *AccountFilter) (*AccountInfo, error) {
+	accounts, err := c.FindAccounts(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(accounts) {
+	case 0:
+		return nil, fmt.Errorf("no account matched the given criteria")
+	case 1:
+		return &accounts[0], nil
+	default:
+		ids := make([]string, 0, len(accounts))
+		for _, account := range accounts {
+			ids = append(ids, account.AccountId)
+		}
+		return nil, fmt.Errorf("%d accounts matched the given criteria (%s); narrow the criteria so that exactly one account matches", len(accounts), strings.Join(ids, ", "))
+	}
+}
+
+// GetAccountTags returns the tags attached to an account
+func (c *Client) GetAccountTags(ctx context.Context, accountID string) (map[string]string, error) {
+	tags := make(map[string]string)
+	var nextToken *string
+
+	for {
+		result, err := c.organizations().ListTagsForResource(ctx, &organizations.ListTagsForResourceInput{
+			ResourceId: aws.String(accountID),
+			NextToken:  nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list tags of account %s: %w", accountID, err)
+		}
+
+		for _, tag := range result.Tags {
+			tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+		}
+
+		if result.NextToken == nil {
+			break
+		}
+		nextToken = result.NextToken
+	}
+
+	return tags, nil
+}
+
+// ListAccountsForParent returns the accounts directly under the given root or
+// organizational unit
+func (c *Client) ListAccountsForParent(ctx context.Context, parentID string) ([]AccountInfo, error) {
+	var accounts []AccountInfo
+	var nextToken *string
+
+	for {
+		result, err := c.organizations().ListAccountsForParent(ctx, &organizations.ListAccountsForParentInput{
+			ParentId:  aws.String(parentID),
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list accounts under %s: %w", parentID, err)
+		}
+
+		for _, account := range result.Accounts {
+			accounts = append(accounts, newAccountInfo(account))
+		}
+
+		if result.NextToken == nil {
+			break
+		}
+		nextToken = result.NextToken
+	}
+
+	return accounts, nil
+}
+
+// listAccountsUnderParent returns the accounts directly under parentID and,
+// when recursive is set, the accounts in every organizational unit below it
+func (c *Client) listAccountsUnderParent(ctx context.Context, parentID string, recursive bool) ([]AccountInfo, error) {
+	accounts, err := c.ListAccountsForParent(ctx, parentID)
+	if err != nil || !recursive {
+		return accounts, err
+	}
+
+	children, err := c.ListOrganizationalUnitsForParent(ctx, parentID)
+	if err != nil {
+		return nil, err
+	}
+	for _, child := range children {
+		childAccounts, err := c.listAccountsUnderParent(ctx, child.Id, true)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, childAccounts...)
+	}
+
+	return accounts, nil
+}
+
+// hasTags reports whether tags contains every key and value in want
+func hasTags(tags, want map[string]string) bool {
+	for key, value := range want {
+		if actual, ok := tags[key]; !ok || actual != value {
+			return false
+		}
+	}
+	return true
+}
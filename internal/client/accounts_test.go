@@ -0,0 +1,119 @@
+package client
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	orgTypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindAccountsByAttributesAndTags(t *testing.T) {
+	mockClient := &mockOrganizationsClient{
+		ListAccountsFunc: func(ctx context.Context, params *organizations.ListAccountsInput, optFns ...func(*organizations.Options)) (*organizations.ListAccountsOutput, error) {
+			return &organizations.ListAccountsOutput{
+				Accounts: []orgTypes.Account{
+					{Id: aws.String("111111111111"), Name: aws.String("prod-app"), Email: aws.String("prod-app@example.com"), Status: orgTypes.AccountStatusActive},
+					{Id: aws.String("222222222222"), Name: aws.String("prod-data"), Email: aws.String("prod-data@example.com"), Status: orgTypes.AccountStatusActive},
+					{Id: aws.String("333333333333"), Name: aws.String("prod-old"), Email: aws.String("prod-old@example.com"), Status: orgTypes.AccountStatusSuspended},
+					{Id: aws.String("444444444444"), Name: aws.String("dev-app"), Email: aws.String("dev-app@example.com"), Status: orgTypes.AccountStatusActive},
+				},
+			}, nil
+		},
+		ListTagsForResourceFunc: func(ctx context.Context, params *organizations.ListTagsForResourceInput, optFns ...func(*organizations.Options)) (*organizations.ListTagsForResourceOutput, error) {
+			// Only the two active prod accounts should have their tags fetched
+			assert.Contains(t, []string{"111111111111", "222222222222"}, aws.ToString(params.ResourceId))
+			team := "app"
+			if aws.ToString(params.ResourceId) == "222222222222" {
+				team = "data"
+			}
+			return &organizations.ListTagsForResourceOutput{
+				Tags: []orgTypes.Tag{{Key: aws.String("team"), Value: aws.String(team)}},
+			}, nil
+		},
+	}
+
+	testClient := &Client{
+		awsConfig: aws.Config{
+			Credentials: credentials.NewStaticCredentialsProvider("test-access-key", "test-secret-key", ""),
+		},
+		orgClient: mockClient,
+	}
+
+	accounts, err := testClient.FindAccounts(context.Background(), &AccountFilter{
+		Status:    "ACTIVE",
+		NameRegex: regexp.MustCompile("^prod-"),
+		Tags:      map[string]string{"team": "app"},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, accounts, 1)
+	assert.Equal(t, "111111111111", accounts[0].AccountId)
+}
+
+func TestFindAccountsUnderParentRecursive(t *testing.T) {
+	accountsByParent := map[string][]orgTypes.Account{
+		"ou-abcd-11111111": {{Id: aws.String("111111111111"), Email: aws.String("a@example.com")}},
+		"ou-abcd-22222222": {{Id: aws.String("222222222222"), Email: aws.String("b@example.com")}},
+	}
+
+	mockClient := &mockOrganizationsClient{
+		ListAccountsForParentFunc: func(ctx context.Context, params *organizations.ListAccountsForParentInput, optFns ...func(*organizations.Options)) (*organizations.ListAccountsForParentOutput, error) {
+			return &organizations.ListAccountsForParentOutput{
+				Accounts: accountsByParent[aws.ToString(params.ParentId)],
+			}, nil
+		},
+		ListOrganizationalUnitsForParentFunc: func(ctx context.Context, params *organizations.ListOrganizationalUnitsForParentInput, optFns ...func(*organizations.Options)) (*organizations.ListOrganizationalUnitsForParentOutput, error) {
+			if aws.ToString(params.ParentId) != "ou-abcd-11111111" {
+				return &organizations.ListOrganizationalUnitsForParentOutput{}, nil
+			}
+			return &organizations.ListOrganizationalUnitsForParentOutput{
+				OrganizationalUnits: []orgTypes.OrganizationalUnit{{Id: aws.String("ou-abcd-22222222")}},
+			}, nil
+		},
+	}
+
+	testClient := &Client{orgClient: mockClient}
+
+	accounts, err := testClient.FindAccounts(context.Background(), &AccountFilter{ParentId: "ou-abcd-11111111"})
+	assert.NoError(t, err)
+	assert.Len(t, accounts, 1)
+
+	accounts, err = testClient.FindAccounts(context.Background(), &AccountFilter{ParentId: "ou-abcd-11111111", Recursive: true})
+	assert.NoError(t, err)
+	assert.Len(t, accounts, 2)
+	assert.Equal(t, "222222222222", accounts[1].AccountId)
+}
+
+func TestFindAccountRequiresExactlyOneMatch(t *testing.T) {
+	mockClient := &mockOrganizationsClient{
+		ListAccountsFunc: func(ctx context.Context, params *organizations.ListAccountsInput, optFns ...func(*organizations.Options)) (*organizations.ListAccountsOutput, error) {
+			return &organizations.ListAccountsOutput{
+				Accounts: []orgTypes.Account{
+					{Id: aws.String("111111111111"), Name: aws.String("shared-networking")},
+					{Id: aws.String("222222222222"), Name: aws.String("shared-services")},
+				},
+			}, nil
+		},
+	}
+
+	testClient := &Client{
+		awsConfig: aws.Config{
+			Credentials: credentials.NewStaticCredentialsProvider("test-access-key", "test-secret-key", ""),
+		},
+		orgClient: mockClient,
+	}
+
+	account, err := testClient.FindAccount(context.Background(), &AccountFilter{NameRegex: regexp.MustCompile("^shared-networking$")})
+	assert.NoError(t, err)
+	assert.Equal(t, "111111111111", account.AccountId)
+
+	_, err = testClient.FindAccount(context.Background(), &AccountFilter{NameRegex: regexp.MustCompile("^shared-")})
+	assert.ErrorContains(t, err, "2 accounts matched")
+
+	_, err = testClient.FindAccount(context.Background(), &AccountFilter{NameRegex: regexp.MustCompile("^missing$")})
+	assert.ErrorContains(t, err, "no account matched")
+}
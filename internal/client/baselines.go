@@ -0,0 +1,189 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/controltower"
+	"github.com/aws/aws-sdk-go-v2/service/controltower/document"
+	ctTypes "github.com/aws/aws-sdk-go-v2/service/controltower/types"
+)
+
+// EnabledBaselineInfo represents a baseline enabled on an organizational unit or account
+type EnabledBaselineInfo struct {
+	Arn                string
+	BaselineIdentifier string
+	BaselineVersion    string
+	TargetIdentifier   string
+	Status             string
+
+	// Parameters maps each baseline parameter key to its JSON-encoded value
+	Parameters map[string]string
+}
+
+// EnableBaseline starts enabling a baseline on the target with the given
+// JSON-encoded parameters and returns the enabled baseline ARN and the
+// operation identifier
+func (c *Client) EnableBaseline(ctx context.Context, baselineIdentifier, baselineVersion, targetIdentifier string, parameters map[string]string) (string, string, error) {
+	baselineParameters, err := newEnabledBaselineParameters(parameters)
+	if err != nil {
+		return "", "", err
+	}
+
+	result, err := c.controlTower().EnableBaseline(ctx, &controltower.EnableBaselineInput{
+		BaselineIdentifier: aws.String(baselineIdentifier),
+		BaselineVersion:    aws.String(baselineVersion),
+		TargetIdentifier:   aws.String(targetIdentifier),
+		Parameters:         baselineParameters,
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("failed to enable baseline %s on %s: %w", baselineIdentifier, targetIdentifier, err)
+	}
+
+	return aws.ToString(result.Arn), aws.ToString(result.OperationIdentifier), nil
+}
+
+// UpdateEnabledBaseline starts updating the version and parameters of an
+// enabled baseline and returns the operation identifier
+func (c *Client) UpdateEnabledBaseline(ctx context.Context, enabledBaselineArn, baselineVersion string, parameters map[string]string) (string, error) {
+	baselineParameters, err := newEnabledBaselineParameters(parameters)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := c.controlTower().UpdateEnabledBaseline(ctx, &controltower.UpdateEnabledBaselineInput{
+		EnabledBaselineIdentifier: aws.String(enabledBaselineArn),
+		BaselineVersion:           aws.String(baselineVersion),
+		Parameters:                baselineParameters,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to update enabled baseline %s: %w", enabledBaselineArn, err)
+	}
+
+	return aws.ToString(result.OperationIdentifier), nil
+}
+
+// ResetEnabledBaseline starts re-applying an enabled baseline to its target
+// and returns the operation identifier
+func (c *Client) ResetEnabledBaseline(ctx context.Context, enabledBaselineArn string) (string, error) {
+	result, err := c.controlTower().ResetEnabledBaseline(ctx, &controltower.ResetEnabledBaselineInput{
+		EnabledBaselineIdentifier: aws.String(enabledBaselineArn),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to reset enabled baseline %s: %w", enabledBaselineArn, err)
+	}
+
+	return aws.ToString(result.OperationIdentifier), nil
+}
+
+// DisableBaseline starts disabling an enabled baseline and returns the
+// operation identifier
+func (c *Client) DisableBaseline(ctx context.Context, enabledBaselineArn string) (string, error) {
+	result, err := c.controlTower().DisableBaseline(ctx, &controltower.DisableBaselineInput{
+		EnabledBaselineIdentifier: aws.String(enabledBaselineArn),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to disable enabled baseline %s: %w", enabledBaselineArn, err)
+	}
+
+	return aws.ToString(result.OperationIdentifier), nil
+}
+
+// WaitForBaselineOperation blocks until the given baseline operation succeeds or fails
+func (c *Client) WaitForBaselineOperation(ctx context.Context, operationID string) error {
+	return c.waitForOperation(ctx, operationID, func(ctx context.Context) (string, string, error) {
+		result, err := c.controlTower().GetBaselineOperation(ctx, &controltower.GetBaselineOperationInput{
+			OperationIdentifier: aws.String(operationID),
+		})
+		if err != nil {
+			return "", "", err
+		}
+		if result.BaselineOperation == nil {
+			return "", "", fmt.Errorf("empty operation details")
+		}
+		return string(result.BaselineOperation.Status), aws.ToString(result.BaselineOperation.StatusMessage), nil
+	})
+}
+
+// GetEnabledBaseline retrieves the enabled baseline with the given ARN
+func (c *Client) GetEnabledBaseline(ctx context.Context, enabledBaselineArn string) (*EnabledBaselineInfo, error) {
+	result, err := c.controlTower().GetEnabledBaseline(ctx, &controltower.GetEnabledBaselineInput{
+		EnabledBaselineIdentifier: aws.String(enabledBaselineArn),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get enabled baseline: %w", err)
+	}
+
+	details := result.EnabledBaselineDetails
+	if details == nil {
+		return nil, fmt.Errorf("failed to get enabled baseline: empty response for %s", enabledBaselineArn)
+	}
+
+	info := &EnabledBaselineInfo{
+		Arn:                aws.ToString(details.Arn),
+		BaselineIdentifier: aws.ToString(details.BaselineIdentifier),
+		BaselineVersion:    aws.ToString(details.BaselineVersion),
+		TargetIdentifier:   aws.ToString(details.TargetIdentifier),
+	}
+	if details.StatusSummary != nil {
+		info.Status = string(details.StatusSummary.Status)
+	}
+	if len(details.Parameters) > 0 {
+		info.Parameters = make(map[string]string, len(details.Parameters))
+		for _, parameter := range details.Parameters {
+			value, err := documentToJSON(parameter.Value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read parameter %s of enabled baseline: %w", aws.ToString(parameter.Key), err)
+			}
+			info.Parameters[aws.ToString(parameter.Key)] = value
+		}
+	}
+
+	return info, nil
+}
+
+// FindEnabledBaseline looks up the baseline enabled on the target. A
+// ResourceNotFoundException is returned when the baseline is not enabled.
+func (c *Client) FindEnabledBaseline(ctx context.Context, baselineIdentifier, targetIdentifier string) (*EnabledBaselineInfo, error) {
+	result, err := c.controlTower().ListEnabledBaselines(ctx, &controltower.ListEnabledBaselinesInput{
+		Filter: &ctTypes.EnabledBaselineFilter{
+			BaselineIdentifiers: []string{baselineIdentifier},
+			TargetIdentifiers:   []string{targetIdentifier},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list enabled baselines for %s: %w", targetIdentifier, err)
+	}
+
+	for _, baseline := range result.EnabledBaselines {
+		if aws.ToString(baseline.BaselineIdentifier) == baselineIdentifier && aws.ToString(baseline.TargetIdentifier) == targetIdentifier {
+			return c.GetEnabledBaseline(ctx, aws.ToString(baseline.Arn))
+		}
+	}
+
+	return nil, &ctTypes.ResourceNotFoundException{
+		Message: aws.String(fmt.Sprintf("baseline %s is not enabled on %s", baselineIdentifier, targetIdentifier)),
+	}
+}
+
+// newEnabledBaselineParameters converts JSON-encoded parameter values into
+// Control Tower enabled baseline parameters, ordered by key
+func newEnabledBaselineParameters(parameters map[string]string) ([]ctTypes.EnabledBaselineParameter, error) {
+	if parameters == nil {
+		return nil, nil
+	}
+
+	baselineParameters := make([]ctTypes.EnabledBaselineParameter, 0, len(parameters))
+	err := forEachJSONParameter(parameters, func(key string, value document.Interface) {
+		baselineParameters = append(baselineParameters, ctTypes.EnabledBaselineParameter{
+			Key:   aws.String(key),
+			Value: value,
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse baseline parameter %w", err)
+	}
+
+	return baselineParameters, nil
+}
@@ -0,0 +1,107 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/controltower"
+	"github.com/aws/aws-sdk-go-v2/service/controltower/document"
+	ctTypes "github.com/aws/aws-sdk-go-v2/service/controltower/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	testBaselineArn        = "arn:aws:controltower:us-west-2::baseline/17BSJV3IGJ2QSGA2"
+	testEnabledBaselineArn = "arn:aws:controltower:us-west-2:123456789012:enabledbaseline/XALULM96QHI525UOC"
+)
+
+func TestEnableBaseline(t *testing.T) {
+	mockCT := new(MockControlTowerAPI)
+
+	mockCT.On("EnableBaseline", mock.Anything, mock.MatchedBy(func(input *controltower.EnableBaselineInput) bool {
+		return aws.ToString(input.BaselineIdentifier) == testBaselineArn &&
+			aws.ToString(input.BaselineVersion) == "4.0" &&
+			aws.ToString(input.TargetIdentifier) == testTargetArn &&
+			len(input.Parameters) == 1 &&
+			aws.ToString(input.Parameters[0].Key) == "IdentityCenterEnabledBaselineArn"
+	})).Return(&controltower.EnableBaselineOutput{
+		Arn:                 aws.String(testEnabledBaselineArn),
+		OperationIdentifier: aws.String("op-1"),
+	}, nil)
+
+	testClient := &Client{ctClient: mockCT}
+
+	arn, operationID, err := testClient.EnableBaseline(context.Background(), testBaselineArn, "4.0", testTargetArn, map[string]string{
+		"IdentityCenterEnabledBaselineArn": `"arn:aws:controltower:us-west-2:123456789012:enabledbaseline/IDENTITY"`,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, testEnabledBaselineArn, arn)
+	assert.Equal(t, "op-1", operationID)
+	mockCT.AssertExpectations(t)
+}
+
+func TestGetEnabledBaseline(t *testing.T) {
+	mockCT := new(MockControlTowerAPI)
+
+	mockCT.On("GetEnabledBaseline", mock.Anything, mock.Anything).
+		Return(&controltower.GetEnabledBaselineOutput{
+			EnabledBaselineDetails: &ctTypes.EnabledBaselineDetails{
+				Arn:                aws.String(testEnabledBaselineArn),
+				BaselineIdentifier: aws.String(testBaselineArn),
+				BaselineVersion:    aws.String("4.0"),
+				TargetIdentifier:   aws.String(testTargetArn),
+				StatusSummary:      &ctTypes.EnablementStatusSummary{Status: ctTypes.EnablementStatusSucceeded},
+				Parameters: []ctTypes.EnabledBaselineParameterSummary{
+					{
+						Key:   aws.String("IdentityCenterEnabledBaselineArn"),
+						Value: document.NewLazyDocument("arn:aws:controltower:us-west-2:123456789012:enabledbaseline/IDENTITY"),
+					},
+				},
+			},
+		}, nil)
+
+	testClient := &Client{ctClient: mockCT}
+
+	info, err := testClient.GetEnabledBaseline(context.Background(), testEnabledBaselineArn)
+	assert.NoError(t, err)
+	assert.Equal(t, "4.0", info.BaselineVersion)
+	assert.Equal(t, "SUCCEEDED", info.Status)
+	assert.Equal(t, map[string]string{
+		"IdentityCenterEnabledBaselineArn": `"arn:aws:controltower:us-west-2:123456789012:enabledbaseline/IDENTITY"`,
+	}, info.Parameters)
+}
+
+func TestFindEnabledBaselineNotFound(t *testing.T) {
+	mockCT := new(MockControlTowerAPI)
+
+	mockCT.On("ListEnabledBaselines", mock.Anything, mock.Anything).
+		Return(&controltower.ListEnabledBaselinesOutput{}, nil)
+
+	testClient := &Client{ctClient: mockCT}
+
+	_, err := testClient.FindEnabledBaseline(context.Background(), testBaselineArn, testTargetArn)
+	assert.True(t, IsNotFound(err))
+}
+
+func TestWaitForBaselineOperationFailed(t *testing.T) {
+	mockCT := new(MockControlTowerAPI)
+
+	mockCT.On("GetBaselineOperation", mock.Anything, mock.Anything).
+		Return(&controltower.GetBaselineOperationOutput{
+			BaselineOperation: &ctTypes.BaselineOperation{
+				Status:        ctTypes.BaselineOperationStatusFailed,
+				StatusMessage: aws.String("IdentityCenterEnabledBaselineArn is required"),
+			},
+		}, nil)
+
+	testClient := &Client{ctClient: mockCT, operationPollInterval: time.Millisecond}
+
+	err := testClient.WaitForBaselineOperation(context.Background(), "op-1")
+	var opErr *OperationFailedError
+	assert.True(t, errors.As(err, &opErr))
+	assert.Equal(t, "IdentityCenterEnabledBaselineArn is required", opErr.StatusMessage)
+}
@@ -2,14 +2,27 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/ssocreds"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/controltower"
+	ctTypes "github.com/aws/aws-sdk-go-v2/service/controltower/types"
 	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	orgTypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/aws/aws-sdk-go-v2/service/servicecatalog"
+	scTypes "github.com/aws/aws-sdk-go-v2/service/servicecatalog/types"
+	"github.com/aws/aws-sdk-go-v2/service/sso"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	stsTypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Client represents the AWS client with assume role support
@@ -17,6 +30,32 @@ type Client struct {
 	awsConfig aws.Config
 	orgClient OrganizationsAPI
 	stsClient STSAPI
+	ctClient  ControlTowerAPI
+	scClient  ServiceCatalogAPI
+	cfClient  CloudFormationAPI
+
+	// credentialSources describes where credentials are looked up, used to
+	// explain failures in VerifyCredentials
+	credentialSources []string
+
+	// endpoints overrides the endpoints of the clients created from awsConfig
+	endpoints Endpoints
+
+	// limiters throttle the requests of the clients created from awsConfig.
+	// They live on the Client so that all resources share them.
+	limiters rateLimiters
+
+	// ssoProfile is the name of the shared config profile the credentials
+	// are resolved from when it is an IAM Identity Center (SSO) profile
+	ssoProfile string
+
+	// now is the clock used to decide when temporary credentials are
+	// refreshed. Defaults to time.Now.
+	now func() time.Time
+
+	// operationPollInterval is the delay between status checks of long-running
+	// Control Tower operations. Defaults to defaultOperationPollInterval.
+	operationPollInterval time.Duration
 }
 
 // AssumeRoleConfig represents the configuration for assuming a role
@@ -33,35 +72,256 @@ type AssumeRoleConfig struct {
 
 // NewClient creates a new AWS client with the given credentials
 func NewClient(accessKey, secretKey, region string) (*Client, error) {
-	cfg, err := config.LoadDefaultConfig(context.TODO(),
-		config.WithRegion(region),
-		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
-			accessKey,
-			secretKey,
-			"",
-		)),
-	)
+	return NewClientFromConfig(context.TODO(), &ClientConfig{
+		AccessKey: accessKey,
+		SecretKey: secretKey,
+		Region:    region,
+	})
+}
+
+// ClientConfig represents the settings used to load the AWS configuration
+type ClientConfig struct {
+	AccessKey    string
+	SecretKey    string
+	SessionToken string
+	Region       string
+
+	// Profile selects a named profile from the shared config and credentials
+	// files. Static keys take precedence over the profile credentials.
+	Profile                string
+	SharedConfigFiles      []string
+	SharedCredentialsFiles []string
+
+	// CredentialProcess is an external command printing credentials in the
+	// credential_process JSON format. It takes precedence over the profile
+	// credentials but not over static keys.
+	CredentialProcess string
+
+	// Endpoints overrides the default service endpoints
+	Endpoints Endpoints
+
+	// MaxAttempts is the maximum number of attempts per AWS call, including
+	// the first one. RetryMode is RetryModeStandard or RetryModeAdaptive, and
+	// MaxBackoff caps the delay between attempts. Zero values use the SDK
+	// defaults.
+	MaxAttempts int
+	RetryMode   string
+	MaxBackoff  time.Duration
+
+	// RateLimits caps the request rate per service
+	RateLimits RateLimits
+}
+
+// Endpoints holds custom service endpoint URLs, such as VPC interface
+// endpoints or local stand-ins. Empty fields use the default endpoint.
+type Endpoints struct {
+	Organizations  string
+	STS            string
+	ControlTower   string
+	ServiceCatalog string
+	CloudFormation string
+	// SSO is the IAM Identity Center portal endpoint used to exchange the
+	// cached SSO token for credentials
+	SSO string
+}
+
+// NewClientFromConfig creates a new AWS client using the SDK default config
+// loader. Static keys, when set, take precedence over shared profiles.
+func NewClientFromConfig(ctx context.Context, clientConfig *ClientConfig) (*Client, error) {
+	var optFns []func(*config.LoadOptions) error
+
+	if clientConfig.Region != "" {
+		optFns = append(optFns, config.WithRegion(clientConfig.Region))
+	}
+	if clientConfig.Profile != "" {
+		optFns = append(optFns, config.WithSharedConfigProfile(clientConfig.Profile))
+	}
+	if len(clientConfig.SharedConfigFiles) > 0 {
+		optFns = append(optFns, config.WithSharedConfigFiles(clientConfig.SharedConfigFiles))
+	}
+	if len(clientConfig.SharedCredentialsFiles) > 0 {
+		optFns = append(optFns, config.WithSharedCredentialsFiles(clientConfig.SharedCredentialsFiles))
+	}
+	if clientConfig.Endpoints.SSO != "" {
+		optFns = append(optFns, config.WithSSOProviderOptions(func(o *ssocreds.Options) {
+			if ssoClient, ok := o.Client.(*sso.Client); ok {
+				o.Client = sso.New(ssoClient.Options(), func(o *sso.Options) {
+					o.BaseEndpoint = aws.String(clientConfig.Endpoints.SSO)
+				})
+			}
+		}))
+	}
+	retryer, err := newRetryer(clientConfig)
+	if err != nil {
+		return nil, err
+	}
+	if retryer != nil {
+		optFns = append(optFns, config.WithRetryer(retryer))
+	}
+	if clientConfig.AccessKey != "" {
+		optFns = append(optFns, config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
+			clientConfig.AccessKey,
+			clientConfig.SecretKey,
+			clientConfig.SessionToken,
+		)))
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx, optFns...)
 	if err != nil {
 		return nil, fmt.Errorf("unable to load SDK config: %w", err)
 	}
 
-	return &Client{
-		awsConfig: cfg,
-	}, nil
+	c := &Client{
+		awsConfig:         cfg,
+		credentialSources: credentialSources(clientConfig),
+		endpoints:         clientConfig.Endpoints,
+		limiters:          newRateLimiters(clientConfig.RateLimits),
+	}
+	if clientConfig.AccessKey == "" && clientConfig.CredentialProcess != "" {
+		// Set after loading, so the provider is used as is rather than behind
+		// the aws.CredentialsCache config loading adds. It caches on its own
+		// and re-runs the command within credentialsExpiryWindow of expiry.
+		c.awsConfig.Credentials = c.newCredentialProcessProvider(clientConfig.CredentialProcess)
+	}
+	if usesSSOCredentials(c.awsConfig.Credentials) {
+		c.ssoProfile = clientConfig.Profile
+		if c.ssoProfile == "" {
+			c.ssoProfile = "default"
+		}
+	}
+
+	return c, nil
 }
 
-// AssumeRole assumes the specified IAM role and returns new credentials
-func (c *Client) AssumeRole(ctx context.Context, assumeRoleConfig *AssumeRoleConfig) error {
-	var stsClient STSAPI
-	if c.stsClient != nil {
-		stsClient = c.stsClient
+// credentialSources describes, in order, where the credentials for the given
+// configuration are looked up
+func credentialSources(clientConfig *ClientConfig) []string {
+	if clientConfig.AccessKey != "" {
+		return []string{"access_key and secret_key in the provider configuration"}
+	}
+	if clientConfig.CredentialProcess != "" {
+		return []string{fmt.Sprintf("credential_process %q in the provider configuration", clientConfig.CredentialProcess)}
+	}
+
+	profile := clientConfig.Profile
+	if profile == "" {
+		profile = "default"
+	}
+	configFiles := "~/.aws/config"
+	if len(clientConfig.SharedConfigFiles) > 0 {
+		configFiles = strings.Join(clientConfig.SharedConfigFiles, ", ")
+	}
+	credentialsFiles := "~/.aws/credentials"
+	if len(clientConfig.SharedCredentialsFiles) > 0 {
+		credentialsFiles = strings.Join(clientConfig.SharedCredentialsFiles, ", ")
+	}
+
+	sources := []string{}
+	if clientConfig.Profile == "" {
+		sources = append(sources,
+			"AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment variables",
+			"web identity token from AWS_WEB_IDENTITY_TOKEN_FILE",
+		)
+	}
+	return append(sources,
+		fmt.Sprintf("profile %q (static keys, credential_process, SSO or role) in the shared config files (%s) and shared credentials files (%s)", profile, configFiles, credentialsFiles),
+		"container credentials (ECS task role, CodeBuild) from AWS_CONTAINER_CREDENTIALS_RELATIVE_URI or AWS_CONTAINER_CREDENTIALS_FULL_URI",
+		"EC2 instance metadata (instance profile)",
+	)
+}
+
+// VerifyCredentials retrieves credentials from the configured sources and
+// returns an error listing the sources that were tried when none yields
+// credentials
+func (c *Client) VerifyCredentials(ctx context.Context) error {
+	var err error
+	if c.awsConfig.Credentials == nil {
+		err = fmt.Errorf("no credentials provider configured")
 	} else {
-		stsClient = sts.NewFromConfig(c.awsConfig)
+		_, err = c.awsConfig.Credentials.Retrieve(ctx)
+	}
+	if err == nil {
+		return nil
+	}
+	if c.ssoProfile != "" && isSSOLoginError(err) {
+		return &SSOLoginRequiredError{Profile: c.ssoProfile, Err: err}
 	}
 
-	// Log the role ARN being assumed
-	fmt.Printf("Attempting to assume role: %s\n", assumeRoleConfig.RoleArn)
+	sources := c.credentialSources
+	if len(sources) == 0 {
+		sources = credentialSources(&ClientConfig{})
+	}
+	tried := make([]string, 0, len(sources))
+	for i, source := range sources {
+		tried = append(tried, fmt.Sprintf("%d. %s", i+1, source))
+	}
 
+	return fmt.Errorf("failed to retrieve AWS credentials: %w\n\nThe following credential sources were tried:\n%s\n\nPlease configure credentials through one of these sources.", err, strings.Join(tried, "\n"))
+}
+
+// Region returns the AWS region the client was configured with, either
+// explicitly or from the shared config profile
+func (c *Client) Region() string {
+	return c.awsConfig.Region
+}
+
+// AssumeRole assumes the specified IAM role and switches the client to the
+// role credentials. The credentials are refreshed by assuming the role again
+// shortly before they expire.
+func (c *Client) AssumeRole(ctx context.Context, assumeRoleConfig *AssumeRoleConfig) error {
+	// The STS client signs with the credentials in use before this role, which
+	// also makes refreshes of chained sessions go through the previous hop
+	stsClient := c.sts()
+
+	logCtx := newLogContext(ctx)
+	tflog.SubsystemDebug(logCtx, logSubsystem, "Assuming IAM role", map[string]interface{}{
+		logKeyRoleArn:     assumeRoleConfig.RoleArn,
+		logKeySessionName: assumeRoleConfig.SessionName,
+		logKeyExternalID:  assumeRoleConfig.ExternalId,
+	})
+
+	input := newAssumeRoleInput(assumeRoleConfig)
+
+	result, err := stsClient.AssumeRole(ctx, input)
+	if err != nil {
+		return fmt.Errorf("failed to assume role: %w\n\nThis could be due to:\n1. Invalid role ARN\n2. Missing trust relationship\n3. Insufficient permissions to assume the role\n4. Invalid external ID (if required)\n5. Invalid session name\n\nPlease check your role configuration and permissions.", err)
+	}
+	if result.Credentials == nil {
+		return fmt.Errorf("failed to assume role %s: empty credentials", assumeRoleConfig.RoleArn)
+	}
+
+	tflog.SubsystemInfo(logCtx, logSubsystem, "Assumed IAM role", map[string]interface{}{
+		logKeyRoleArn:        assumeRoleConfig.RoleArn,
+		logKeyAssumedRoleArn: aws.ToString(result.AssumedRoleUser.Arn),
+		logKeyAccessKeyID:    aws.ToString(result.Credentials.AccessKeyId),
+	})
+
+	// Update the client's credentials with the assumed role credentials
+	c.awsConfig.Credentials = c.newRefreshingCredentials(
+		newSTSCredentials(result.Credentials, assumeRoleCredentialsSource),
+		func(ctx context.Context) (aws.Credentials, error) {
+			result, err := stsClient.AssumeRole(ctx, input)
+			if err != nil {
+				return aws.Credentials{}, fmt.Errorf("failed to refresh credentials of role %s: %w", assumeRoleConfig.RoleArn, err)
+			}
+			if result.Credentials == nil {
+				return aws.Credentials{}, fmt.Errorf("failed to refresh credentials of role %s: empty credentials", assumeRoleConfig.RoleArn)
+			}
+			return newSTSCredentials(result.Credentials, assumeRoleCredentialsSource), nil
+		},
+	)
+
+	return nil
+}
+
+// Sources reported in the credentials obtained from STS
+const (
+	assumeRoleCredentialsSource  = "AssumeRole"
+	webIdentityCredentialsSource = "AssumeRoleWithWebIdentity"
+)
+
+// newAssumeRoleInput builds the STS request for the given role configuration
+func newAssumeRoleInput(assumeRoleConfig *AssumeRoleConfig) *sts.AssumeRoleInput {
 	input := &sts.AssumeRoleInput{
 		RoleArn: aws.String(assumeRoleConfig.RoleArn),
 	}
@@ -108,39 +368,172 @@ func (c *Client) AssumeRole(ctx context.Context, assumeRoleConfig *AssumeRoleCon
 		input.TransitiveTagKeys = assumeRoleConfig.TransitiveTagKeys
 	}
 
-	result, err := stsClient.AssumeRole(ctx, input)
-	if err != nil {
-		return fmt.Errorf("failed to assume role: %w\n\nThis could be due to:\n1. Invalid role ARN\n2. Missing trust relationship\n3. Insufficient permissions to assume the role\n4. Invalid external ID (if required)\n5. Invalid session name\n\nPlease check your role configuration and permissions.", err)
+	return input
+}
+
+// AssumeRoleHopError reports which hop of a role chain could not be assumed
+type AssumeRoleHopError struct {
+	// Hop is the zero-based position of the role in the chain
+	Hop     int
+	RoleArn string
+	Err     error
+}
+
+func (e *AssumeRoleHopError) Error() string {
+	return fmt.Sprintf("assume_role hop %d (%s) failed: %s", e.Hop+1, e.RoleArn, e.Err)
+}
+
+func (e *AssumeRoleHopError) Unwrap() error {
+	return e.Err
+}
+
+// AssumeRoleChain assumes each role in order, with every hop using the
+// credentials obtained from the previous one. A failure is reported as an
+// *AssumeRoleHopError naming the hop.
+func (c *Client) AssumeRoleChain(ctx context.Context, assumeRoleConfigs []*AssumeRoleConfig) error {
+	for i, assumeRoleConfig := range assumeRoleConfigs {
+		if err := c.AssumeRole(ctx, assumeRoleConfig); err != nil {
+			return &AssumeRoleHopError{
+				Hop:     i,
+				RoleArn: assumeRoleConfig.RoleArn,
+				Err:     err,
+			}
+		}
 	}
+	return nil
+}
 
-	// Log successful role assumption
-	fmt.Printf("Successfully assumed role. Session name: %s\n", aws.ToString(result.AssumedRoleUser.Arn))
+// WebIdentityConfig represents the configuration for assuming a role with an
+// OIDC web identity token
+type WebIdentityConfig struct {
+	RoleArn     string
+	SessionName string
+	// Exactly one of WebIdentityToken or WebIdentityTokenFile is used; the
+	// file is read each time the role is assumed
+	WebIdentityToken     string
+	WebIdentityTokenFile string
+	DurationSeconds      int32
+}
 
-	// Update the client's credentials with the assumed role credentials
-	c.awsConfig.Credentials = credentials.NewStaticCredentialsProvider(
-		aws.ToString(result.Credentials.AccessKeyId),
-		aws.ToString(result.Credentials.SecretAccessKey),
-		aws.ToString(result.Credentials.SessionToken),
-	)
+// defaultWebIdentitySessionName is used when no session name is configured,
+// since AssumeRoleWithWebIdentity requires one
+const defaultWebIdentitySessionName = "terraform-provider-controltowermanagement"
+
+// AssumeRoleWithWebIdentity exchanges an OIDC web identity token for
+// credentials of the specified IAM role. No prior AWS credentials are needed.
+// The credentials are refreshed shortly before they expire, re-reading the
+// token file so that rotated tokens are picked up.
+func (c *Client) AssumeRoleWithWebIdentity(ctx context.Context, webIdentityConfig *WebIdentityConfig) error {
+	stsClient := c.sts()
+
+	assume := func(ctx context.Context) (aws.Credentials, error) {
+		token, err := webIdentityConfig.token()
+		if err != nil {
+			return aws.Credentials{}, err
+		}
+
+		input := &sts.AssumeRoleWithWebIdentityInput{
+			RoleArn:          aws.String(webIdentityConfig.RoleArn),
+			RoleSessionName:  aws.String(webIdentityConfig.SessionName),
+			WebIdentityToken: aws.String(token),
+		}
+		if webIdentityConfig.SessionName == "" {
+			input.RoleSessionName = aws.String(defaultWebIdentitySessionName)
+		}
+		if webIdentityConfig.DurationSeconds > 0 {
+			input.DurationSeconds = aws.Int32(webIdentityConfig.DurationSeconds)
+		}
+
+		result, err := stsClient.AssumeRoleWithWebIdentity(ctx, input)
+		if err != nil {
+			return aws.Credentials{}, fmt.Errorf("failed to assume role %s with web identity: %w\n\nThis could be due to:\n1. Invalid role ARN\n2. Missing or mismatched OIDC identity provider in IAM\n3. Trust policy conditions not matching the token audience or subject\n4. Expired web identity token\n\nPlease check your role trust policy and OIDC provider configuration.", webIdentityConfig.RoleArn, err)
+		}
+		if result.Credentials == nil {
+			return aws.Credentials{}, fmt.Errorf("failed to assume role %s with web identity: empty credentials", webIdentityConfig.RoleArn)
+		}
+		return newSTSCredentials(result.Credentials, webIdentityCredentialsSource), nil
+	}
+
+	creds, err := assume(ctx)
+	if err != nil {
+		return err
+	}
+
+	c.awsConfig.Credentials = c.newRefreshingCredentials(creds, assume)
 
 	return nil
 }
 
-// GetAccountInfo retrieves information about AWS accounts from AWS Organizations
-func (c *Client) GetAccountInfo(ctx context.Context) ([]AccountInfo, error) {
-	var orgClient OrganizationsAPI
+// token returns the configured web identity token, reading it from the token
+// file when one is set
+func (w *WebIdentityConfig) token() (string, error) {
+	token := w.WebIdentityToken
+	if w.WebIdentityTokenFile != "" {
+		contents, err := os.ReadFile(w.WebIdentityTokenFile)
+		if err != nil {
+			return "", fmt.Errorf("failed to read web identity token file %s: %w", w.WebIdentityTokenFile, err)
+		}
+		token = strings.TrimSpace(string(contents))
+	}
+	if token == "" {
+		return "", fmt.Errorf("failed to assume role %s with web identity: web identity token is empty", w.RoleArn)
+	}
+	return token, nil
+}
+
+// sts returns the STS client, creating one from the current AWS configuration
+// when no client has been injected
+func (c *Client) sts() STSAPI {
+	if c.stsClient != nil {
+		return c.stsClient
+	}
+	return sts.NewFromConfig(c.awsConfig, func(o *sts.Options) {
+		if c.endpoints.STS != "" {
+			o.BaseEndpoint = aws.String(c.endpoints.STS)
+		}
+		o.APIOptions = append(o.APIOptions, c.limiters.sts.addMiddleware, addTraceMiddleware)
+	})
+}
+
+// IsNotFound reports whether err indicates that the requested AWS resource
+// does not exist
+func IsNotFound(err error) bool {
+	var ctNotFound *ctTypes.ResourceNotFoundException
+	var scNotFound *scTypes.ResourceNotFoundException
+	var accountNotFound *orgTypes.AccountNotFoundException
+	var ouNotFound *orgTypes.OrganizationalUnitNotFoundException
+	var childNotFound *orgTypes.ChildNotFoundException
+	return errors.As(err, &ctNotFound) || errors.As(err, &scNotFound) ||
+		errors.As(err, &accountNotFound) || errors.As(err, &ouNotFound) ||
+		errors.As(err, &childNotFound)
+}
+
+// organizations returns the Organizations client, creating one from the
+// current AWS configuration when no client has been injected
+func (c *Client) organizations() OrganizationsAPI {
 	if c.orgClient != nil {
-		orgClient = c.orgClient
-	} else {
-		orgClient = organizations.NewFromConfig(c.awsConfig)
+		return c.orgClient
 	}
+	return organizations.NewFromConfig(c.awsConfig, func(o *organizations.Options) {
+		if c.endpoints.Organizations != "" {
+			o.BaseEndpoint = aws.String(c.endpoints.Organizations)
+		}
+		o.APIOptions = append(o.APIOptions, c.limiters.organizations.addMiddleware, addTraceMiddleware)
+	})
+}
 
-	// Log the current credentials being used
-	creds, err := c.awsConfig.Credentials.Retrieve(context.TODO())
+// GetAccountInfo retrieves information about AWS accounts from AWS Organizations
+func (c *Client) GetAccountInfo(ctx context.Context) ([]AccountInfo, error) {
+	orgClient := c.organizations()
+
+	creds, err := c.awsConfig.Credentials.Retrieve(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve credentials: %w", err)
 	}
-	fmt.Printf("Using credentials for access key: %s\n", creds.AccessKeyID)
+	tflog.SubsystemDebug(newLogContext(ctx), logSubsystem, "Listing accounts in the organization", map[string]interface{}{
+		logKeyAccessKeyID:       creds.AccessKeyID,
+		logKeyCredentialsSource: creds.Source,
+	})
 
 	var accounts []AccountInfo
 	var nextToken *string
@@ -156,12 +549,7 @@ func (c *Client) GetAccountInfo(ctx context.Context) ([]AccountInfo, error) {
 		}
 
 		for _, account := range result.Accounts {
-			accounts = append(accounts, AccountInfo{
-				AccountId:   aws.ToString(account.Id),
-				AccountName: aws.ToString(account.Name),
-				Email:       aws.ToString(account.Email),
-				Status:      string(account.Status),
-			})
+			accounts = append(accounts, newAccountInfo(account))
 		}
 
 		if result.NextToken == nil {
@@ -173,12 +561,146 @@ func (c *Client) GetAccountInfo(ctx context.Context) ([]AccountInfo, error) {
 	return accounts, nil
 }
 
+// ResolveAccountParents fills in the parent ID and parent path of each
+// account by walking up from it, sharing the organizational unit lookups
+// between accounts. Accounts that left the organization since they were
+// listed keep an empty parent.
+func (c *Client) ResolveAccountParents(ctx context.Context, accounts []AccountInfo) error {
+	resolver := &parentResolver{client: c, paths: map[string]string{}}
+	for i := range accounts {
+		if err := resolver.resolve(ctx, &accounts[i]); err != nil && !IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// ResolveAccountParent fills in the parent ID and parent path of a single
+// account by walking up from its parent to the root, which avoids listing the
+// whole organizational unit tree
+func (c *Client) ResolveAccountParent(ctx context.Context, account *AccountInfo) error {
+	resolver := &parentResolver{client: c, paths: map[string]string{}}
+	return resolver.resolve(ctx, account)
+}
+
+// parentResolver builds the paths of roots and organizational units from the
+// bottom up, remembering every path it has built
+type parentResolver struct {
+	client *Client
+	paths  map[string]string
+}
+
+// resolve fills in the parent ID and parent path of account
+func (r *parentResolver) resolve(ctx context.Context, account *AccountInfo) error {
+	parent, err := r.client.getParent(ctx, account.AccountId)
+	if err != nil {
+		return err
+	}
+
+	parentPath, err := r.path(ctx, parent)
+	if err != nil {
+		return err
+	}
+
+	account.ParentId = aws.ToString(parent.Id)
+	account.ParentPath = parentPath
+	return nil
+}
+
+// path returns the path of a root or organizational unit, e.g.
+// Root/Workloads/Prod
+func (r *parentResolver) path(ctx context.Context, parent *orgTypes.Parent) (string, error) {
+	parentID := aws.ToString(parent.Id)
+	if parentPath, ok := r.paths[parentID]; ok {
+		return parentPath, nil
+	}
+
+	if parent.Type != orgTypes.ParentTypeOrganizationalUnit {
+		root, err := r.client.GetRoot(ctx)
+		if err != nil {
+			return "", err
+		}
+		r.paths[parentID] = root.Name
+		return root.Name, nil
+	}
+
+	result, err := r.client.organizations().DescribeOrganizationalUnit(ctx, &organizations.DescribeOrganizationalUnitInput{
+		OrganizationalUnitId: aws.String(parentID),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to describe organizational unit %s: %w", parentID, err)
+	}
+	if result.OrganizationalUnit == nil {
+		return "", fmt.Errorf("failed to describe organizational unit %s: empty response", parentID)
+	}
+
+	grandparent, err := r.client.getParent(ctx, parentID)
+	if err != nil {
+		return "", err
+	}
+	grandparentPath, err := r.path(ctx, grandparent)
+	if err != nil {
+		return "", err
+	}
+
+	parentPath := grandparentPath + "/" + aws.ToString(result.OrganizationalUnit.Name)
+	r.paths[parentID] = parentPath
+	return parentPath, nil
+}
+
 // AccountInfo represents information about an AWS account
 type AccountInfo struct {
-	AccountId   string
-	AccountName string
-	Email       string
-	Status      string
+	AccountId       string
+	AccountName     string
+	Email           string
+	Status          string
+	Arn             string
+	JoinedMethod    string
+	JoinedTimestamp time.Time
+	// ParentId and ParentPath are only populated by ResolveAccountParents and
+	// ResolveAccountParent
+	ParentId   string
+	ParentPath string
+}
+
+// newAccountInfo converts an AWS Organizations account into an AccountInfo
+func newAccountInfo(account orgTypes.Account) AccountInfo {
+	return AccountInfo{
+		AccountId:       aws.ToString(account.Id),
+		AccountName:     aws.ToString(account.Name),
+		Email:           aws.ToString(account.Email),
+		Status:          string(account.Status),
+		Arn:             aws.ToString(account.Arn),
+		JoinedMethod:    string(account.JoinedMethod),
+		JoinedTimestamp: aws.ToTime(account.JoinedTimestamp),
+	}
+}
+
+// DescribeAccount retrieves information about a single AWS account from AWS Organizations
+func (c *Client) DescribeAccount(ctx context.Context, accountID string) (*AccountInfo, error) {
+	result, err := c.organizations().DescribeAccount(ctx, &organizations.DescribeAccountInput{
+		AccountId: aws.String(accountID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe account %s: %w", accountID, err)
+	}
+	if result.Account == nil {
+		return nil, fmt.Errorf("failed to describe account %s: empty response", accountID)
+	}
+
+	info := newAccountInfo(*result.Account)
+	return &info, nil
+}
+
+// CloseAccount closes an AWS account that is a member of the organization
+func (c *Client) CloseAccount(ctx context.Context, accountID string) error {
+	_, err := c.organizations().CloseAccount(ctx, &organizations.CloseAccountInput{
+		AccountId: aws.String(accountID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to close account %s: %w", accountID, err)
+	}
+	return nil
 }
 
 // SetSessionToken sets the AWS session token for temporary credentials
@@ -201,9 +723,63 @@ func (c *Client) SetSessionToken(token string) error {
 // OrganizationsAPI defines the interface for AWS Organizations operations
 type OrganizationsAPI interface {
 	ListAccounts(ctx context.Context, params *organizations.ListAccountsInput, optFns ...func(*organizations.Options)) (*organizations.ListAccountsOutput, error)
+	DescribeAccount(ctx context.Context, params *organizations.DescribeAccountInput, optFns ...func(*organizations.Options)) (*organizations.DescribeAccountOutput, error)
+	ListParents(ctx context.Context, params *organizations.ListParentsInput, optFns ...func(*organizations.Options)) (*organizations.ListParentsOutput, error)
+	CloseAccount(ctx context.Context, params *organizations.CloseAccountInput, optFns ...func(*organizations.Options)) (*organizations.CloseAccountOutput, error)
+	CreateOrganizationalUnit(ctx context.Context, params *organizations.CreateOrganizationalUnitInput, optFns ...func(*organizations.Options)) (*organizations.CreateOrganizationalUnitOutput, error)
+	DescribeOrganizationalUnit(ctx context.Context, params *organizations.DescribeOrganizationalUnitInput, optFns ...func(*organizations.Options)) (*organizations.DescribeOrganizationalUnitOutput, error)
+	UpdateOrganizationalUnit(ctx context.Context, params *organizations.UpdateOrganizationalUnitInput, optFns ...func(*organizations.Options)) (*organizations.UpdateOrganizationalUnitOutput, error)
+	DeleteOrganizationalUnit(ctx context.Context, params *organizations.DeleteOrganizationalUnitInput, optFns ...func(*organizations.Options)) (*organizations.DeleteOrganizationalUnitOutput, error)
+	ListRoots(ctx context.Context, params *organizations.ListRootsInput, optFns ...func(*organizations.Options)) (*organizations.ListRootsOutput, error)
+	ListOrganizationalUnitsForParent(ctx context.Context, params *organizations.ListOrganizationalUnitsForParentInput, optFns ...func(*organizations.Options)) (*organizations.ListOrganizationalUnitsForParentOutput, error)
+	ListAccountsForParent(ctx context.Context, params *organizations.ListAccountsForParentInput, optFns ...func(*organizations.Options)) (*organizations.ListAccountsForParentOutput, error)
+	ListTagsForResource(ctx context.Context, params *organizations.ListTagsForResourceInput, optFns ...func(*organizations.Options)) (*organizations.ListTagsForResourceOutput, error)
+	DescribeOrganization(ctx context.Context, params *organizations.DescribeOrganizationInput, optFns ...func(*organizations.Options)) (*organizations.DescribeOrganizationOutput, error)
+	ListDelegatedAdministrators(ctx context.Context, params *organizations.ListDelegatedAdministratorsInput, optFns ...func(*organizations.Options)) (*organizations.ListDelegatedAdministratorsOutput, error)
 }
 
 // STSAPI defines the interface for AWS STS operations
 type STSAPI interface {
 	AssumeRole(ctx context.Context, params *sts.AssumeRoleInput, optFns ...func(*sts.Options)) (*sts.AssumeRoleOutput, error)
+	AssumeRoleWithWebIdentity(ctx context.Context, params *sts.AssumeRoleWithWebIdentityInput, optFns ...func(*sts.Options)) (*sts.AssumeRoleWithWebIdentityOutput, error)
+	GetCallerIdentity(ctx context.Context, params *sts.GetCallerIdentityInput, optFns ...func(*sts.Options)) (*sts.GetCallerIdentityOutput, error)
+}
+
+// ControlTowerAPI defines the interface for AWS Control Tower operations
+type ControlTowerAPI interface {
+	CreateLandingZone(ctx context.Context, params *controltower.CreateLandingZoneInput, optFns ...func(*controltower.Options)) (*controltower.CreateLandingZoneOutput, error)
+	GetLandingZone(ctx context.Context, params *controltower.GetLandingZoneInput, optFns ...func(*controltower.Options)) (*controltower.GetLandingZoneOutput, error)
+	UpdateLandingZone(ctx context.Context, params *controltower.UpdateLandingZoneInput, optFns ...func(*controltower.Options)) (*controltower.UpdateLandingZoneOutput, error)
+	ResetLandingZone(ctx context.Context, params *controltower.ResetLandingZoneInput, optFns ...func(*controltower.Options)) (*controltower.ResetLandingZoneOutput, error)
+	DeleteLandingZone(ctx context.Context, params *controltower.DeleteLandingZoneInput, optFns ...func(*controltower.Options)) (*controltower.DeleteLandingZoneOutput, error)
+	GetLandingZoneOperation(ctx context.Context, params *controltower.GetLandingZoneOperationInput, optFns ...func(*controltower.Options)) (*controltower.GetLandingZoneOperationOutput, error)
+	EnableControl(ctx context.Context, params *controltower.EnableControlInput, optFns ...func(*controltower.Options)) (*controltower.EnableControlOutput, error)
+	DisableControl(ctx context.Context, params *controltower.DisableControlInput, optFns ...func(*controltower.Options)) (*controltower.DisableControlOutput, error)
+	GetControlOperation(ctx context.Context, params *controltower.GetControlOperationInput, optFns ...func(*controltower.Options)) (*controltower.GetControlOperationOutput, error)
+	GetEnabledControl(ctx context.Context, params *controltower.GetEnabledControlInput, optFns ...func(*controltower.Options)) (*controltower.GetEnabledControlOutput, error)
+	ListEnabledControls(ctx context.Context, params *controltower.ListEnabledControlsInput, optFns ...func(*controltower.Options)) (*controltower.ListEnabledControlsOutput, error)
+	UpdateEnabledControl(ctx context.Context, params *controltower.UpdateEnabledControlInput, optFns ...func(*controltower.Options)) (*controltower.UpdateEnabledControlOutput, error)
+	EnableBaseline(ctx context.Context, params *controltower.EnableBaselineInput, optFns ...func(*controltower.Options)) (*controltower.EnableBaselineOutput, error)
+	UpdateEnabledBaseline(ctx context.Context, params *controltower.UpdateEnabledBaselineInput, optFns ...func(*controltower.Options)) (*controltower.UpdateEnabledBaselineOutput, error)
+	ResetEnabledBaseline(ctx context.Context, params *controltower.ResetEnabledBaselineInput, optFns ...func(*controltower.Options)) (*controltower.ResetEnabledBaselineOutput, error)
+	DisableBaseline(ctx context.Context, params *controltower.DisableBaselineInput, optFns ...func(*controltower.Options)) (*controltower.DisableBaselineOutput, error)
+	GetEnabledBaseline(ctx context.Context, params *controltower.GetEnabledBaselineInput, optFns ...func(*controltower.Options)) (*controltower.GetEnabledBaselineOutput, error)
+	ListEnabledBaselines(ctx context.Context, params *controltower.ListEnabledBaselinesInput, optFns ...func(*controltower.Options)) (*controltower.ListEnabledBaselinesOutput, error)
+	GetBaselineOperation(ctx context.Context, params *controltower.GetBaselineOperationInput, optFns ...func(*controltower.Options)) (*controltower.GetBaselineOperationOutput, error)
+}
+
+// ServiceCatalogAPI defines the interface for AWS Service Catalog operations
+type ServiceCatalogAPI interface {
+	DescribeProduct(ctx context.Context, params *servicecatalog.DescribeProductInput, optFns ...func(*servicecatalog.Options)) (*servicecatalog.DescribeProductOutput, error)
+	ProvisionProduct(ctx context.Context, params *servicecatalog.ProvisionProductInput, optFns ...func(*servicecatalog.Options)) (*servicecatalog.ProvisionProductOutput, error)
+	UpdateProvisionedProduct(ctx context.Context, params *servicecatalog.UpdateProvisionedProductInput, optFns ...func(*servicecatalog.Options)) (*servicecatalog.UpdateProvisionedProductOutput, error)
+	TerminateProvisionedProduct(ctx context.Context, params *servicecatalog.TerminateProvisionedProductInput, optFns ...func(*servicecatalog.Options)) (*servicecatalog.TerminateProvisionedProductOutput, error)
+	DescribeProvisionedProduct(ctx context.Context, params *servicecatalog.DescribeProvisionedProductInput, optFns ...func(*servicecatalog.Options)) (*servicecatalog.DescribeProvisionedProductOutput, error)
+	GetProvisionedProductOutputs(ctx context.Context, params *servicecatalog.GetProvisionedProductOutputsInput, optFns ...func(*servicecatalog.Options)) (*servicecatalog.GetProvisionedProductOutputsOutput, error)
+	DescribeRecord(ctx context.Context, params *servicecatalog.DescribeRecordInput, optFns ...func(*servicecatalog.Options)) (*servicecatalog.DescribeRecordOutput, error)
+}
+
+// CloudFormationAPI defines the interface for AWS CloudFormation operations
+type CloudFormationAPI interface {
+	DescribeStacks(ctx context.Context, params *cloudformation.DescribeStacksInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DescribeStacksOutput, error)
 }
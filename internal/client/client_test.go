@@ -2,13 +2,22 @@ package client
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/controltower"
 	"github.com/aws/aws-sdk-go-v2/service/organizations"
 	orgTypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/aws/aws-sdk-go-v2/service/servicecatalog"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	stsTypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
 	"github.com/stretchr/testify/assert"
@@ -28,8 +37,125 @@ func (m *MockOrganizationsAPI) ListAccounts(ctx context.Context, params *organiz
 	return args.Get(0).(*organizations.ListAccountsOutput), args.Error(1)
 }
 
+func (m *MockOrganizationsAPI) DescribeAccount(ctx context.Context, params *organizations.DescribeAccountInput, optFns ...func(*organizations.Options)) (*organizations.DescribeAccountOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*organizations.DescribeAccountOutput), args.Error(1)
+}
+
+func (m *MockOrganizationsAPI) ListParents(ctx context.Context, params *organizations.ListParentsInput, optFns ...func(*organizations.Options)) (*organizations.ListParentsOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*organizations.ListParentsOutput), args.Error(1)
+}
+
+func (m *MockOrganizationsAPI) CloseAccount(ctx context.Context, params *organizations.CloseAccountInput, optFns ...func(*organizations.Options)) (*organizations.CloseAccountOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*organizations.CloseAccountOutput), args.Error(1)
+}
+
+func (m *MockOrganizationsAPI) CreateOrganizationalUnit(ctx context.Context, params *organizations.CreateOrganizationalUnitInput, optFns ...func(*organizations.Options)) (*organizations.CreateOrganizationalUnitOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*organizations.CreateOrganizationalUnitOutput), args.Error(1)
+}
+
+func (m *MockOrganizationsAPI) DescribeOrganizationalUnit(ctx context.Context, params *organizations.DescribeOrganizationalUnitInput, optFns ...func(*organizations.Options)) (*organizations.DescribeOrganizationalUnitOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*organizations.DescribeOrganizationalUnitOutput), args.Error(1)
+}
+
+func (m *MockOrganizationsAPI) UpdateOrganizationalUnit(ctx context.Context, params *organizations.UpdateOrganizationalUnitInput, optFns ...func(*organizations.Options)) (*organizations.UpdateOrganizationalUnitOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*organizations.UpdateOrganizationalUnitOutput), args.Error(1)
+}
+
+func (m *MockOrganizationsAPI) DeleteOrganizationalUnit(ctx context.Context, params *organizations.DeleteOrganizationalUnitInput, optFns ...func(*organizations.Options)) (*organizations.DeleteOrganizationalUnitOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*organizations.DeleteOrganizationalUnitOutput), args.Error(1)
+}
+
+func (m *MockOrganizationsAPI) ListRoots(ctx context.Context, params *organizations.ListRootsInput, optFns ...func(*organizations.Options)) (*organizations.ListRootsOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*organizations.ListRootsOutput), args.Error(1)
+}
+
+func (m *MockOrganizationsAPI) ListOrganizationalUnitsForParent(ctx context.Context, params *organizations.ListOrganizationalUnitsForParentInput, optFns ...func(*organizations.Options)) (*organizations.ListOrganizationalUnitsForParentOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*organizations.ListOrganizationalUnitsForParentOutput), args.Error(1)
+}
+
+func (m *MockOrganizationsAPI) ListAccountsForParent(ctx context.Context, params *organizations.ListAccountsForParentInput, optFns ...func(*organizations.Options)) (*organizations.ListAccountsForParentOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*organizations.ListAccountsForParentOutput), args.Error(1)
+}
+
+func (m *MockOrganizationsAPI) ListTagsForResource(ctx context.Context, params *organizations.ListTagsForResourceInput, optFns ...func(*organizations.Options)) (*organizations.ListTagsForResourceOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*organizations.ListTagsForResourceOutput), args.Error(1)
+}
+
+func (m *MockOrganizationsAPI) DescribeOrganization(ctx context.Context, params *organizations.DescribeOrganizationInput, optFns ...func(*organizations.Options)) (*organizations.DescribeOrganizationOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*organizations.DescribeOrganizationOutput), args.Error(1)
+}
+
+func (m *MockOrganizationsAPI) ListDelegatedAdministrators(ctx context.Context, params *organizations.ListDelegatedAdministratorsInput, optFns ...func(*organizations.Options)) (*organizations.ListDelegatedAdministratorsOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*organizations.ListDelegatedAdministratorsOutput), args.Error(1)
+}
+
 type mockOrganizationsClient struct {
-	ListAccountsFunc func(ctx context.Context, params *organizations.ListAccountsInput, optFns ...func(*organizations.Options)) (*organizations.ListAccountsOutput, error)
+	ListAccountsFunc                     func(ctx context.Context, params *organizations.ListAccountsInput, optFns ...func(*organizations.Options)) (*organizations.ListAccountsOutput, error)
+	DescribeAccountFunc                  func(ctx context.Context, params *organizations.DescribeAccountInput, optFns ...func(*organizations.Options)) (*organizations.DescribeAccountOutput, error)
+	ListParentsFunc                      func(ctx context.Context, params *organizations.ListParentsInput, optFns ...func(*organizations.Options)) (*organizations.ListParentsOutput, error)
+	CloseAccountFunc                     func(ctx context.Context, params *organizations.CloseAccountInput, optFns ...func(*organizations.Options)) (*organizations.CloseAccountOutput, error)
+	CreateOrganizationalUnitFunc         func(ctx context.Context, params *organizations.CreateOrganizationalUnitInput, optFns ...func(*organizations.Options)) (*organizations.CreateOrganizationalUnitOutput, error)
+	DescribeOrganizationalUnitFunc       func(ctx context.Context, params *organizations.DescribeOrganizationalUnitInput, optFns ...func(*organizations.Options)) (*organizations.DescribeOrganizationalUnitOutput, error)
+	UpdateOrganizationalUnitFunc         func(ctx context.Context, params *organizations.UpdateOrganizationalUnitInput, optFns ...func(*organizations.Options)) (*organizations.UpdateOrganizationalUnitOutput, error)
+	DeleteOrganizationalUnitFunc         func(ctx context.Context, params *organizations.DeleteOrganizationalUnitInput, optFns ...func(*organizations.Options)) (*organizations.DeleteOrganizationalUnitOutput, error)
+	ListRootsFunc                        func(ctx context.Context, params *organizations.ListRootsInput, optFns ...func(*organizations.Options)) (*organizations.ListRootsOutput, error)
+	ListOrganizationalUnitsForParentFunc func(ctx context.Context, params *organizations.ListOrganizationalUnitsForParentInput, optFns ...func(*organizations.Options)) (*organizations.ListOrganizationalUnitsForParentOutput, error)
+	ListAccountsForParentFunc            func(ctx context.Context, params *organizations.ListAccountsForParentInput, optFns ...func(*organizations.Options)) (*organizations.ListAccountsForParentOutput, error)
+	ListTagsForResourceFunc              func(ctx context.Context, params *organizations.ListTagsForResourceInput, optFns ...func(*organizations.Options)) (*organizations.ListTagsForResourceOutput, error)
+	DescribeOrganizationFunc             func(ctx context.Context, params *organizations.DescribeOrganizationInput, optFns ...func(*organizations.Options)) (*organizations.DescribeOrganizationOutput, error)
+	ListDelegatedAdministratorsFunc      func(ctx context.Context, params *organizations.ListDelegatedAdministratorsInput, optFns ...func(*organizations.Options)) (*organizations.ListDelegatedAdministratorsOutput, error)
 }
 
 func (m *mockOrganizationsClient) ListAccounts(ctx context.Context, params *organizations.ListAccountsInput, optFns ...func(*organizations.Options)) (*organizations.ListAccountsOutput, error) {
@@ -39,6 +165,97 @@ func (m *mockOrganizationsClient) ListAccounts(ctx context.Context, params *orga
 	return nil, nil
 }
 
+func (m *mockOrganizationsClient) DescribeAccount(ctx context.Context, params *organizations.DescribeAccountInput, optFns ...func(*organizations.Options)) (*organizations.DescribeAccountOutput, error) {
+	if m.DescribeAccountFunc != nil {
+		return m.DescribeAccountFunc(ctx, params, optFns...)
+	}
+	return nil, nil
+}
+
+func (m *mockOrganizationsClient) ListParents(ctx context.Context, params *organizations.ListParentsInput, optFns ...func(*organizations.Options)) (*organizations.ListParentsOutput, error) {
+	if m.ListParentsFunc != nil {
+		return m.ListParentsFunc(ctx, params, optFns...)
+	}
+	return nil, nil
+}
+
+func (m *mockOrganizationsClient) CloseAccount(ctx context.Context, params *organizations.CloseAccountInput, optFns ...func(*organizations.Options)) (*organizations.CloseAccountOutput, error) {
+	if m.CloseAccountFunc != nil {
+		return m.CloseAccountFunc(ctx, params, optFns...)
+	}
+	return nil, nil
+}
+
+func (m *mockOrganizationsClient) CreateOrganizationalUnit(ctx context.Context, params *organizations.CreateOrganizationalUnitInput, optFns ...func(*organizations.Options)) (*organizations.CreateOrganizationalUnitOutput, error) {
+	if m.CreateOrganizationalUnitFunc != nil {
+		return m.CreateOrganizationalUnitFunc(ctx, params, optFns...)
+	}
+	return nil, nil
+}
+
+func (m *mockOrganizationsClient) DescribeOrganizationalUnit(ctx context.Context, params *organizations.DescribeOrganizationalUnitInput, optFns ...func(*organizations.Options)) (*organizations.DescribeOrganizationalUnitOutput, error) {
+	if m.DescribeOrganizationalUnitFunc != nil {
+		return m.DescribeOrganizationalUnitFunc(ctx, params, optFns...)
+	}
+	return nil, nil
+}
+
+func (m *mockOrganizationsClient) UpdateOrganizationalUnit(ctx context.Context, params *organizations.UpdateOrganizationalUnitInput, optFns ...func(*organizations.Options)) (*organizations.UpdateOrganizationalUnitOutput, error) {
+	if m.UpdateOrganizationalUnitFunc != nil {
+		return m.UpdateOrganizationalUnitFunc(ctx, params, optFns...)
+	}
+	return nil, nil
+}
+
+func (m *mockOrganizationsClient) DeleteOrganizationalUnit(ctx context.Context, params *organizations.DeleteOrganizationalUnitInput, optFns ...func(*organizations.Options)) (*organizations.DeleteOrganizationalUnitOutput, error) {
+	if m.DeleteOrganizationalUnitFunc != nil {
+		return m.DeleteOrganizationalUnitFunc(ctx, params, optFns...)
+	}
+	return nil, nil
+}
+
+func (m *mockOrganizationsClient) ListRoots(ctx context.Context, params *organizations.ListRootsInput, optFns ...func(*organizations.Options)) (*organizations.ListRootsOutput, error) {
+	if m.ListRootsFunc != nil {
+		return m.ListRootsFunc(ctx, params, optFns...)
+	}
+	return nil, nil
+}
+
+func (m *mockOrganizationsClient) ListOrganizationalUnitsForParent(ctx context.Context, params *organizations.ListOrganizationalUnitsForParentInput, optFns ...func(*organizations.Options)) (*organizations.ListOrganizationalUnitsForParentOutput, error) {
+	if m.ListOrganizationalUnitsForParentFunc != nil {
+		return m.ListOrganizationalUnitsForParentFunc(ctx, params, optFns...)
+	}
+	return nil, nil
+}
+
+func (m *mockOrganizationsClient) ListAccountsForParent(ctx context.Context, params *organizations.ListAccountsForParentInput, optFns ...func(*organizations.Options)) (*organizations.ListAccountsForParentOutput, error) {
+	if m.ListAccountsForParentFunc != nil {
+		return m.ListAccountsForParentFunc(ctx, params, optFns...)
+	}
+	return nil, nil
+}
+
+func (m *mockOrganizationsClient) ListTagsForResource(ctx context.Context, params *organizations.ListTagsForResourceInput, optFns ...func(*organizations.Options)) (*organizations.ListTagsForResourceOutput, error) {
+	if m.ListTagsForResourceFunc != nil {
+		return m.ListTagsForResourceFunc(ctx, params, optFns...)
+	}
+	return nil, nil
+}
+
+func (m *mockOrganizationsClient) DescribeOrganization(ctx context.Context, params *organizations.DescribeOrganizationInput, optFns ...func(*organizations.Options)) (*organizations.DescribeOrganizationOutput, error) {
+	if m.DescribeOrganizationFunc != nil {
+		return m.DescribeOrganizationFunc(ctx, params, optFns...)
+	}
+	return nil, nil
+}
+
+func (m *mockOrganizationsClient) ListDelegatedAdministrators(ctx context.Context, params *organizations.ListDelegatedAdministratorsInput, optFns ...func(*organizations.Options)) (*organizations.ListDelegatedAdministratorsOutput, error) {
+	if m.ListDelegatedAdministratorsFunc != nil {
+		return m.ListDelegatedAdministratorsFunc(ctx, params, optFns...)
+	}
+	return nil, nil
+}
+
 // MockSTSAPI is a mock implementation of the STS API
 type MockSTSAPI struct {
 	mock.Mock
@@ -52,6 +269,389 @@ func (m *MockSTSAPI) AssumeRole(ctx context.Context, params *sts.AssumeRoleInput
 	return args.Get(0).(*sts.AssumeRoleOutput), args.Error(1)
 }
 
+func (m *MockSTSAPI) AssumeRoleWithWebIdentity(ctx context.Context, params *sts.AssumeRoleWithWebIdentityInput, optFns ...func(*sts.Options)) (*sts.AssumeRoleWithWebIdentityOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*sts.AssumeRoleWithWebIdentityOutput), args.Error(1)
+}
+
+func (m *MockSTSAPI) GetCallerIdentity(ctx context.Context, params *sts.GetCallerIdentityInput, optFns ...func(*sts.Options)) (*sts.GetCallerIdentityOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*sts.GetCallerIdentityOutput), args.Error(1)
+}
+
+// MockControlTowerAPI is a mock implementation of the Control Tower API
+type MockControlTowerAPI struct {
+	mock.Mock
+}
+
+func (m *MockControlTowerAPI) CreateLandingZone(ctx context.Context, params *controltower.CreateLandingZoneInput, optFns ...func(*controltower.Options)) (*controltower.CreateLandingZoneOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*controltower.CreateLandingZoneOutput), args.Error(1)
+}
+
+func (m *MockControlTowerAPI) GetLandingZone(ctx context.Context, params *controltower.GetLandingZoneInput, optFns ...func(*controltower.Options)) (*controltower.GetLandingZoneOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*controltower.GetLandingZoneOutput), args.Error(1)
+}
+
+func (m *MockControlTowerAPI) UpdateLandingZone(ctx context.Context, params *controltower.UpdateLandingZoneInput, optFns ...func(*controltower.Options)) (*controltower.UpdateLandingZoneOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*controltower.UpdateLandingZoneOutput), args.Error(1)
+}
+
+func (m *MockControlTowerAPI) ResetLandingZone(ctx context.Context, params *controltower.ResetLandingZoneInput, optFns ...func(*controltower.Options)) (*controltower.ResetLandingZoneOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*controltower.ResetLandingZoneOutput), args.Error(1)
+}
+
+func (m *MockControlTowerAPI) DeleteLandingZone(ctx context.Context, params *controltower.DeleteLandingZoneInput, optFns ...func(*controltower.Options)) (*controltower.DeleteLandingZoneOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*controltower.DeleteLandingZoneOutput), args.Error(1)
+}
+
+func (m *MockControlTowerAPI) GetLandingZoneOperation(ctx context.Context, params *controltower.GetLandingZoneOperationInput, optFns ...func(*controltower.Options)) (*controltower.GetLandingZoneOperationOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*controltower.GetLandingZoneOperationOutput), args.Error(1)
+}
+
+func (m *MockControlTowerAPI) EnableControl(ctx context.Context, params *controltower.EnableControlInput, optFns ...func(*controltower.Options)) (*controltower.EnableControlOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*controltower.EnableControlOutput), args.Error(1)
+}
+
+func (m *MockControlTowerAPI) DisableControl(ctx context.Context, params *controltower.DisableControlInput, optFns ...func(*controltower.Options)) (*controltower.DisableControlOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*controltower.DisableControlOutput), args.Error(1)
+}
+
+func (m *MockControlTowerAPI) GetControlOperation(ctx context.Context, params *controltower.GetControlOperationInput, optFns ...func(*controltower.Options)) (*controltower.GetControlOperationOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*controltower.GetControlOperationOutput), args.Error(1)
+}
+
+func (m *MockControlTowerAPI) GetEnabledControl(ctx context.Context, params *controltower.GetEnabledControlInput, optFns ...func(*controltower.Options)) (*controltower.GetEnabledControlOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*controltower.GetEnabledControlOutput), args.Error(1)
+}
+
+func (m *MockControlTowerAPI) ListEnabledControls(ctx context.Context, params *controltower.ListEnabledControlsInput, optFns ...func(*controltower.Options)) (*controltower.ListEnabledControlsOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*controltower.ListEnabledControlsOutput), args.Error(1)
+}
+
+func (m *MockControlTowerAPI) UpdateEnabledControl(ctx context.Context, params *controltower.UpdateEnabledControlInput, optFns ...func(*controltower.Options)) (*controltower.UpdateEnabledControlOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*controltower.UpdateEnabledControlOutput), args.Error(1)
+}
+
+func (m *MockControlTowerAPI) EnableBaseline(ctx context.Context, params *controltower.EnableBaselineInput, optFns ...func(*controltower.Options)) (*controltower.EnableBaselineOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*controltower.EnableBaselineOutput), args.Error(1)
+}
+
+func (m *MockControlTowerAPI) UpdateEnabledBaseline(ctx context.Context, params *controltower.UpdateEnabledBaselineInput, optFns ...func(*controltower.Options)) (*controltower.UpdateEnabledBaselineOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*controltower.UpdateEnabledBaselineOutput), args.Error(1)
+}
+
+func (m *MockControlTowerAPI) ResetEnabledBaseline(ctx context.Context, params *controltower.ResetEnabledBaselineInput, optFns ...func(*controltower.Options)) (*controltower.ResetEnabledBaselineOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*controltower.ResetEnabledBaselineOutput), args.Error(1)
+}
+
+func (m *MockControlTowerAPI) DisableBaseline(ctx context.Context, params *controltower.DisableBaselineInput, optFns ...func(*controltower.Options)) (*controltower.DisableBaselineOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*controltower.DisableBaselineOutput), args.Error(1)
+}
+
+func (m *MockControlTowerAPI) GetEnabledBaseline(ctx context.Context, params *controltower.GetEnabledBaselineInput, optFns ...func(*controltower.Options)) (*controltower.GetEnabledBaselineOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*controltower.GetEnabledBaselineOutput), args.Error(1)
+}
+
+func (m *MockControlTowerAPI) ListEnabledBaselines(ctx context.Context, params *controltower.ListEnabledBaselinesInput, optFns ...func(*controltower.Options)) (*controltower.ListEnabledBaselinesOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*controltower.ListEnabledBaselinesOutput), args.Error(1)
+}
+
+func (m *MockControlTowerAPI) GetBaselineOperation(ctx context.Context, params *controltower.GetBaselineOperationInput, optFns ...func(*controltower.Options)) (*controltower.GetBaselineOperationOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*controltower.GetBaselineOperationOutput), args.Error(1)
+}
+
+// MockServiceCatalogAPI is a mock implementation of the Service Catalog API
+type MockServiceCatalogAPI struct {
+	mock.Mock
+}
+
+func (m *MockServiceCatalogAPI) DescribeProduct(ctx context.Context, params *servicecatalog.DescribeProductInput, optFns ...func(*servicecatalog.Options)) (*servicecatalog.DescribeProductOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*servicecatalog.DescribeProductOutput), args.Error(1)
+}
+
+func (m *MockServiceCatalogAPI) ProvisionProduct(ctx context.Context, params *servicecatalog.ProvisionProductInput, optFns ...func(*servicecatalog.Options)) (*servicecatalog.ProvisionProductOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*servicecatalog.ProvisionProductOutput), args.Error(1)
+}
+
+func (m *MockServiceCatalogAPI) UpdateProvisionedProduct(ctx context.Context, params *servicecatalog.UpdateProvisionedProductInput, optFns ...func(*servicecatalog.Options)) (*servicecatalog.UpdateProvisionedProductOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*servicecatalog.UpdateProvisionedProductOutput), args.Error(1)
+}
+
+func (m *MockServiceCatalogAPI) TerminateProvisionedProduct(ctx context.Context, params *servicecatalog.TerminateProvisionedProductInput, optFns ...func(*servicecatalog.Options)) (*servicecatalog.TerminateProvisionedProductOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*servicecatalog.TerminateProvisionedProductOutput), args.Error(1)
+}
+
+func (m *MockServiceCatalogAPI) DescribeProvisionedProduct(ctx context.Context, params *servicecatalog.DescribeProvisionedProductInput, optFns ...func(*servicecatalog.Options)) (*servicecatalog.DescribeProvisionedProductOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*servicecatalog.DescribeProvisionedProductOutput), args.Error(1)
+}
+
+func (m *MockServiceCatalogAPI) GetProvisionedProductOutputs(ctx context.Context, params *servicecatalog.GetProvisionedProductOutputsInput, optFns ...func(*servicecatalog.Options)) (*servicecatalog.GetProvisionedProductOutputsOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*servicecatalog.GetProvisionedProductOutputsOutput), args.Error(1)
+}
+
+func (m *MockServiceCatalogAPI) DescribeRecord(ctx context.Context, params *servicecatalog.DescribeRecordInput, optFns ...func(*servicecatalog.Options)) (*servicecatalog.DescribeRecordOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*servicecatalog.DescribeRecordOutput), args.Error(1)
+}
+
+// MockCloudFormationAPI is a mock implementation of the CloudFormation API
+type MockCloudFormationAPI struct {
+	mock.Mock
+}
+
+func (m *MockCloudFormationAPI) DescribeStacks(ctx context.Context, params *cloudformation.DescribeStacksInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DescribeStacksOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*cloudformation.DescribeStacksOutput), args.Error(1)
+}
+
+// writeSharedConfigFiles writes a shared config and credentials file with a
+// "test-profile" profile and returns their paths
+func writeSharedConfigFiles(t *testing.T) (string, string) {
+	dir := t.TempDir()
+
+	configFile := filepath.Join(dir, "config")
+	err := os.WriteFile(configFile, []byte("[profile test-profile]\nregion = eu-west-1\n"), 0o600)
+	assert.NoError(t, err)
+
+	credentialsFile := filepath.Join(dir, "credentials")
+	err = os.WriteFile(credentialsFile, []byte("[test-profile]\naws_access_key_id = profile-access-key\naws_secret_access_key = profile-secret-key\n"), 0o600)
+	assert.NoError(t, err)
+
+	return configFile, credentialsFile
+}
+
+func TestNewClientFromConfigProfile(t *testing.T) {
+	isolateCredentialChain(t)
+	t.Setenv("AWS_REGION", "")
+	configFile, credentialsFile := writeSharedConfigFiles(t)
+
+	testClient, err := NewClientFromConfig(context.Background(), &ClientConfig{
+		Profile:                "test-profile",
+		SharedConfigFiles:      []string{configFile},
+		SharedCredentialsFiles: []string{credentialsFile},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "eu-west-1", testClient.Region())
+
+	creds, err := testClient.awsConfig.Credentials.Retrieve(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "profile-access-key", creds.AccessKeyID)
+	assert.Equal(t, "profile-secret-key", creds.SecretAccessKey)
+}
+
+func TestNewClientFromConfigStaticKeysTakePrecedence(t *testing.T) {
+	configFile, credentialsFile := writeSharedConfigFiles(t)
+
+	testClient, err := NewClientFromConfig(context.Background(), &ClientConfig{
+		AccessKey:              "static-access-key",
+		SecretKey:              "static-secret-key",
+		Region:                 "us-west-2",
+		Profile:                "test-profile",
+		SharedConfigFiles:      []string{configFile},
+		SharedCredentialsFiles: []string{credentialsFile},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "us-west-2", testClient.Region())
+
+	creds, err := testClient.awsConfig.Credentials.Retrieve(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "static-access-key", creds.AccessKeyID)
+}
+
+func TestNewClientFromConfigEndpoints(t *testing.T) {
+	stsServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "GetCallerIdentity", r.Form.Get("Action"))
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprint(w, `<GetCallerIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <GetCallerIdentityResult>
+    <Arn>arn:aws:iam::111111111111:user/terraform</Arn>
+    <UserId>AIDAEXAMPLE</UserId>
+    <Account>111111111111</Account>
+  </GetCallerIdentityResult>
+  <ResponseMetadata><RequestId>request-id</RequestId></ResponseMetadata>
+</GetCallerIdentityResponse>`)
+	}))
+	defer stsServer.Close()
+
+	orgServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "AWSOrganizationsV20161128.DescribeOrganization", r.Header.Get("X-Amz-Target"))
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		fmt.Fprint(w, `{"Organization":{"Id":"o-abcd1234","MasterAccountId":"111111111111"}}`)
+	}))
+	defer orgServer.Close()
+
+	testClient, err := NewClientFromConfig(context.Background(), &ClientConfig{
+		AccessKey: "test-access-key",
+		SecretKey: "test-secret-key",
+		Region:    "us-west-2",
+		Endpoints: Endpoints{
+			Organizations: orgServer.URL,
+			STS:           stsServer.URL,
+		},
+	})
+	assert.NoError(t, err)
+
+	// Both calls only succeed when they reach the local servers
+	assert.NoError(t, testClient.VerifyManagementAccount(context.Background()))
+}
+
+// isolateCredentialChain points every default credential source at an empty
+// location so that tests don't pick up credentials from the host
+func isolateCredentialChain(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+	t.Setenv("AWS_CONFIG_FILE", filepath.Join(dir, "config"))
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", filepath.Join(dir, "credentials"))
+	t.Setenv("AWS_ACCESS_KEY_ID", "")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "")
+	t.Setenv("AWS_SESSION_TOKEN", "")
+	t.Setenv("AWS_PROFILE", "")
+	t.Setenv("AWS_WEB_IDENTITY_TOKEN_FILE", "")
+	t.Setenv("AWS_CONTAINER_CREDENTIALS_RELATIVE_URI", "")
+	t.Setenv("AWS_CONTAINER_CREDENTIALS_FULL_URI", "")
+	t.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+}
+
+func TestVerifyCredentialsFromEnvironment(t *testing.T) {
+	isolateCredentialChain(t)
+	t.Setenv("AWS_ACCESS_KEY_ID", "env-access-key")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "env-secret-key")
+
+	testClient, err := NewClientFromConfig(context.Background(), &ClientConfig{Region: "us-west-2"})
+	assert.NoError(t, err)
+	assert.NoError(t, testClient.VerifyCredentials(context.Background()))
+
+	creds, err := testClient.awsConfig.Credentials.Retrieve(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "env-access-key", creds.AccessKeyID)
+}
+
+func TestVerifyCredentialsNoSources(t *testing.T) {
+	isolateCredentialChain(t)
+
+	testClient, err := NewClientFromConfig(context.Background(), &ClientConfig{Region: "us-west-2"})
+	assert.NoError(t, err)
+
+	err = testClient.VerifyCredentials(context.Background())
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment variables")
+	assert.ErrorContains(t, err, `profile "default"`)
+	assert.ErrorContains(t, err, "EC2 instance metadata")
+}
+
 func TestGetAccountInfo(t *testing.T) {
 	// Create a mock client that returns test data
 	mockClient := &mockOrganizationsClient{
@@ -59,10 +659,13 @@ func TestGetAccountInfo(t *testing.T) {
 			return &organizations.ListAccountsOutput{
 				Accounts: []orgTypes.Account{
 					{
-						Id:     aws.String("123456789012"),
-						Name:   aws.String("Test Account 1"),
-						Email:  aws.String("test1@example.com"),
-						Status: orgTypes.AccountStatusActive,
+						Id:              aws.String("123456789012"),
+						Arn:             aws.String("arn:aws:organizations::111111111111:account/o-abcdefghij/123456789012"),
+						Name:            aws.String("Test Account 1"),
+						Email:           aws.String("test1@example.com"),
+						Status:          orgTypes.AccountStatusActive,
+						JoinedMethod:    orgTypes.AccountJoinedMethodCreated,
+						JoinedTimestamp: aws.Time(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
 					},
 					{
 						Id:     aws.String("098765432109"),
@@ -100,9 +703,109 @@ func TestGetAccountInfo(t *testing.T) {
 		assert.Equal(t, "Test Account 1", accounts[0].AccountName)
 		assert.Equal(t, "test1@example.com", accounts[0].Email)
 		assert.Equal(t, "ACTIVE", accounts[0].Status)
+		assert.Equal(t, "arn:aws:organizations::111111111111:account/o-abcdefghij/123456789012", accounts[0].Arn)
+		assert.Equal(t, "CREATED", accounts[0].JoinedMethod)
+		assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), accounts[0].JoinedTimestamp)
 	}
 }
 
+func TestResolveAccountParents(t *testing.T) {
+	parents := map[string]orgTypes.Parent{
+		"123456789012":     {Id: aws.String("r-abcd"), Type: orgTypes.ParentTypeRoot},
+		"098765432109":     {Id: aws.String("ou-abcd-12345678"), Type: orgTypes.ParentTypeOrganizationalUnit},
+		"555555555555":     {Id: aws.String("ou-abcd-12345678"), Type: orgTypes.ParentTypeOrganizationalUnit},
+		"ou-abcd-12345678": {Id: aws.String("r-abcd"), Type: orgTypes.ParentTypeRoot},
+	}
+	var describeCalls int
+	mockClient := &mockOrganizationsClient{
+		ListRootsFunc: func(ctx context.Context, params *organizations.ListRootsInput, optFns ...func(*organizations.Options)) (*organizations.ListRootsOutput, error) {
+			return &organizations.ListRootsOutput{
+				Roots: []orgTypes.Root{{Id: aws.String("r-abcd"), Name: aws.String("Root")}},
+			}, nil
+		},
+		ListParentsFunc: func(ctx context.Context, params *organizations.ListParentsInput, optFns ...func(*organizations.Options)) (*organizations.ListParentsOutput, error) {
+			parent, ok := parents[aws.ToString(params.ChildId)]
+			if !ok {
+				return nil, &orgTypes.ChildNotFoundException{Message: aws.String("not found")}
+			}
+			return &organizations.ListParentsOutput{Parents: []orgTypes.Parent{parent}}, nil
+		},
+		DescribeOrganizationalUnitFunc: func(ctx context.Context, params *organizations.DescribeOrganizationalUnitInput, optFns ...func(*organizations.Options)) (*organizations.DescribeOrganizationalUnitOutput, error) {
+			describeCalls++
+			return &organizations.DescribeOrganizationalUnitOutput{
+				OrganizationalUnit: &orgTypes.OrganizationalUnit{Id: params.OrganizationalUnitId, Name: aws.String("Workloads")},
+			}, nil
+		},
+		ListOrganizationalUnitsForParentFunc: func(ctx context.Context, params *organizations.ListOrganizationalUnitsForParentInput, optFns ...func(*organizations.Options)) (*organizations.ListOrganizationalUnitsForParentOutput, error) {
+			t.Fatal("ListOrganizationalUnitsForParent should not be called to resolve parents")
+			return nil, nil
+		},
+	}
+
+	testClient := &Client{orgClient: mockClient}
+
+	// 111111111111 left the organization after it was listed
+	accounts := []AccountInfo{
+		{AccountId: "123456789012"},
+		{AccountId: "098765432109"},
+		{AccountId: "555555555555"},
+		{AccountId: "111111111111"},
+	}
+	err := testClient.ResolveAccountParents(context.Background(), accounts)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "r-abcd", accounts[0].ParentId)
+	assert.Equal(t, "Root", accounts[0].ParentPath)
+	assert.Equal(t, "ou-abcd-12345678", accounts[1].ParentId)
+	assert.Equal(t, "Root/Workloads", accounts[1].ParentPath)
+	assert.Equal(t, "Root/Workloads", accounts[2].ParentPath)
+	assert.Empty(t, accounts[3].ParentId)
+	assert.Empty(t, accounts[3].ParentPath)
+	assert.Equal(t, 1, describeCalls)
+}
+
+func TestResolveAccountParent(t *testing.T) {
+	parents := map[string]orgTypes.Parent{
+		"098765432109":     {Id: aws.String("ou-abcd-22222222"), Type: orgTypes.ParentTypeOrganizationalUnit},
+		"ou-abcd-22222222": {Id: aws.String("ou-abcd-12345678"), Type: orgTypes.ParentTypeOrganizationalUnit},
+		"ou-abcd-12345678": {Id: aws.String("r-abcd"), Type: orgTypes.ParentTypeRoot},
+	}
+	names := map[string]string{
+		"ou-abcd-12345678": "Workloads",
+		"ou-abcd-22222222": "Prod",
+	}
+	mockClient := &mockOrganizationsClient{
+		ListRootsFunc: func(ctx context.Context, params *organizations.ListRootsInput, optFns ...func(*organizations.Options)) (*organizations.ListRootsOutput, error) {
+			return &organizations.ListRootsOutput{
+				Roots: []orgTypes.Root{{Id: aws.String("r-abcd"), Name: aws.String("Root")}},
+			}, nil
+		},
+		ListParentsFunc: func(ctx context.Context, params *organizations.ListParentsInput, optFns ...func(*organizations.Options)) (*organizations.ListParentsOutput, error) {
+			return &organizations.ListParentsOutput{
+				Parents: []orgTypes.Parent{parents[aws.ToString(params.ChildId)]},
+			}, nil
+		},
+		DescribeOrganizationalUnitFunc: func(ctx context.Context, params *organizations.DescribeOrganizationalUnitInput, optFns ...func(*organizations.Options)) (*organizations.DescribeOrganizationalUnitOutput, error) {
+			ouID := aws.ToString(params.OrganizationalUnitId)
+			return &organizations.DescribeOrganizationalUnitOutput{
+				OrganizationalUnit: &orgTypes.OrganizationalUnit{Id: aws.String(ouID), Name: aws.String(names[ouID])},
+			}, nil
+		},
+		ListOrganizationalUnitsForParentFunc: func(ctx context.Context, params *organizations.ListOrganizationalUnitsForParentInput, optFns ...func(*organizations.Options)) (*organizations.ListOrganizationalUnitsForParentOutput, error) {
+			t.Fatal("ListOrganizationalUnitsForParent should not be called for a single account")
+			return nil, nil
+		},
+	}
+
+	testClient := &Client{orgClient: mockClient}
+
+	account := &AccountInfo{AccountId: "098765432109"}
+	err := testClient.ResolveAccountParent(context.Background(), account)
+	assert.NoError(t, err)
+	assert.Equal(t, "ou-abcd-22222222", account.ParentId)
+	assert.Equal(t, "Root/Workloads/Prod", account.ParentPath)
+}
+
 func TestAssumeRole(t *testing.T) {
 	// Create mock STS client
 	mockSTS := new(MockSTSAPI)
@@ -161,3 +864,272 @@ func TestAssumeRole(t *testing.T) {
 	assert.Equal(t, "test-secret-key", creds.SecretAccessKey)
 	assert.Equal(t, "test-session-token", creds.SessionToken)
 }
+
+func TestAssumeRoleWithWebIdentityTokenFile(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	err := os.WriteFile(tokenFile, []byte("oidc-token\n"), 0o600)
+	assert.NoError(t, err)
+
+	mockSTS := new(MockSTSAPI)
+	mockSTS.On("AssumeRoleWithWebIdentity", mock.Anything, mock.MatchedBy(func(input *sts.AssumeRoleWithWebIdentityInput) bool {
+		return aws.ToString(input.RoleArn) == "arn:aws:iam::123456789012:role/GitHubActions" &&
+			aws.ToString(input.WebIdentityToken) == "oidc-token" &&
+			aws.ToString(input.RoleSessionName) == defaultWebIdentitySessionName &&
+			aws.ToInt32(input.DurationSeconds) == 900
+	})).Return(&sts.AssumeRoleWithWebIdentityOutput{
+		Credentials: &stsTypes.Credentials{
+			AccessKeyId:     aws.String("web-identity-access-key"),
+			SecretAccessKey: aws.String("web-identity-secret-key"),
+			SessionToken:    aws.String("web-identity-session-token"),
+			Expiration:      aws.Time(time.Now().Add(15 * time.Minute)),
+		},
+	}, nil)
+
+	testClient := &Client{
+		awsConfig: aws.Config{Region: "us-west-2"},
+		stsClient: mockSTS,
+	}
+
+	err = testClient.AssumeRoleWithWebIdentity(context.Background(), &WebIdentityConfig{
+		RoleArn:              "arn:aws:iam::123456789012:role/GitHubActions",
+		WebIdentityTokenFile: tokenFile,
+		DurationSeconds:      900,
+	})
+	assert.NoError(t, err)
+	mockSTS.AssertExpectations(t)
+
+	creds, err := testClient.awsConfig.Credentials.Retrieve(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "web-identity-access-key", creds.AccessKeyID)
+	assert.Equal(t, "web-identity-session-token", creds.SessionToken)
+}
+
+func TestAssumeRoleWithWebIdentityEmptyToken(t *testing.T) {
+	mockSTS := new(MockSTSAPI)
+	testClient := &Client{stsClient: mockSTS}
+
+	err := testClient.AssumeRoleWithWebIdentity(context.Background(), &WebIdentityConfig{
+		RoleArn: "arn:aws:iam::123456789012:role/GitHubActions",
+	})
+	assert.ErrorContains(t, err, "web identity token is empty")
+	mockSTS.AssertNotCalled(t, "AssumeRoleWithWebIdentity", mock.Anything, mock.Anything)
+}
+
+func TestAssumeRoleChain(t *testing.T) {
+	mockSTS := new(MockSTSAPI)
+
+	testClient := &Client{
+		awsConfig: aws.Config{
+			Region:      "us-west-2",
+			Credentials: credentials.NewStaticCredentialsProvider("base-access-key", "base-secret-key", ""),
+		},
+		stsClient: mockSTS,
+	}
+
+	// Each hop must be signed with the credentials of the previous hop
+	expectHop := func(roleArn, callerAccessKey, resultAccessKey string) {
+		mockSTS.On("AssumeRole", mock.Anything, mock.MatchedBy(func(input *sts.AssumeRoleInput) bool {
+			return aws.ToString(input.RoleArn) == roleArn
+		})).Run(func(args mock.Arguments) {
+			creds, err := testClient.awsConfig.Credentials.Retrieve(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, callerAccessKey, creds.AccessKeyID)
+		}).Return(&sts.AssumeRoleOutput{
+			Credentials: &stsTypes.Credentials{
+				AccessKeyId:     aws.String(resultAccessKey),
+				SecretAccessKey: aws.String(resultAccessKey + "-secret"),
+				SessionToken:    aws.String(resultAccessKey + "-token"),
+			},
+			AssumedRoleUser: &stsTypes.AssumedRoleUser{Arn: aws.String(roleArn)},
+		}, nil).Once()
+	}
+	expectHop("arn:aws:iam::111111111111:role/terraform-broker", "base-access-key", "broker-access-key")
+	expectHop("arn:aws:iam::222222222222:role/AWSControlTowerExecution", "broker-access-key", "management-access-key")
+
+	err := testClient.AssumeRoleChain(context.Background(), []*AssumeRoleConfig{
+		{RoleArn: "arn:aws:iam::111111111111:role/terraform-broker"},
+		{RoleArn: "arn:aws:iam::222222222222:role/AWSControlTowerExecution"},
+	})
+	assert.NoError(t, err)
+	mockSTS.AssertExpectations(t)
+
+	creds, err := testClient.awsConfig.Credentials.Retrieve(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "management-access-key", creds.AccessKeyID)
+}
+
+func TestAssumeRoleChainNamesFailedHop(t *testing.T) {
+	mockSTS := new(MockSTSAPI)
+	mockSTS.On("AssumeRole", mock.Anything, mock.MatchedBy(func(input *sts.AssumeRoleInput) bool {
+		return aws.ToString(input.RoleArn) == "arn:aws:iam::111111111111:role/terraform-broker"
+	})).Return(&sts.AssumeRoleOutput{
+		Credentials: &stsTypes.Credentials{
+			AccessKeyId:     aws.String("broker-access-key"),
+			SecretAccessKey: aws.String("broker-secret-key"),
+			SessionToken:    aws.String("broker-session-token"),
+		},
+		AssumedRoleUser: &stsTypes.AssumedRoleUser{Arn: aws.String("arn:aws:sts::111111111111:assumed-role/terraform-broker/session")},
+	}, nil)
+	mockSTS.On("AssumeRole", mock.Anything, mock.Anything).Return(nil, errors.New("AccessDenied"))
+
+	testClient := &Client{
+		awsConfig: aws.Config{
+			Credentials: credentials.NewStaticCredentialsProvider("base-access-key", "base-secret-key", ""),
+		},
+		stsClient: mockSTS,
+	}
+
+	err := testClient.AssumeRoleChain(context.Background(), []*AssumeRoleConfig{
+		{RoleArn: "arn:aws:iam::111111111111:role/terraform-broker"},
+		{RoleArn: "arn:aws:iam::222222222222:role/AWSControlTowerExecution"},
+	})
+
+	var hopErr *AssumeRoleHopError
+	assert.True(t, errors.As(err, &hopErr))
+	assert.Equal(t, 1, hopErr.Hop)
+	assert.Equal(t, "arn:aws:iam::222222222222:role/AWSControlTowerExecution", hopErr.RoleArn)
+	assert.ErrorContains(t, err, "assume_role hop 2")
+}
+
+func TestAssumeRoleRefreshesBeforeExpiry(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	mockSTS := new(MockSTSAPI)
+
+	// The refresh must repeat the original request, session tags included
+	expectAssume := func(accessKey string, expires time.Time) {
+		mockSTS.On("AssumeRole", mock.Anything, mock.MatchedBy(func(input *sts.AssumeRoleInput) bool {
+			return aws.ToString(input.RoleArn) == "arn:aws:iam::123456789012:role/test-role" &&
+				len(input.Tags) == 1 && aws.ToString(input.Tags[0].Key) == "team"
+		})).Return(&sts.AssumeRoleOutput{
+			Credentials: &stsTypes.Credentials{
+				AccessKeyId:     aws.String(accessKey),
+				SecretAccessKey: aws.String(accessKey + "-secret"),
+				SessionToken:    aws.String(accessKey + "-token"),
+				Expiration:      aws.Time(expires),
+			},
+			AssumedRoleUser: &stsTypes.AssumedRoleUser{Arn: aws.String("arn:aws:sts::123456789012:assumed-role/test-role/session")},
+		}, nil).Once()
+	}
+	expectAssume("first-access-key", now.Add(time.Hour))
+	expectAssume("second-access-key", now.Add(2*time.Hour))
+
+	testClient := &Client{
+		awsConfig: aws.Config{
+			Credentials: credentials.NewStaticCredentialsProvider("base-access-key", "base-secret-key", ""),
+		},
+		stsClient: mockSTS,
+		now:       func() time.Time { return now },
+	}
+
+	err := testClient.AssumeRole(context.Background(), &AssumeRoleConfig{
+		RoleArn: "arn:aws:iam::123456789012:role/test-role",
+		Tags:    map[string]string{"team": "platform"},
+	})
+	assert.NoError(t, err)
+
+	// Still outside the expiry window, so the cached credentials are returned
+	now = now.Add(50 * time.Minute)
+	creds, err := testClient.awsConfig.Credentials.Retrieve(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "first-access-key", creds.AccessKeyID)
+	mockSTS.AssertNumberOfCalls(t, "AssumeRole", 1)
+
+	// Within the expiry window the role is assumed again
+	now = now.Add(6 * time.Minute)
+	creds, err = testClient.awsConfig.Credentials.Retrieve(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "second-access-key", creds.AccessKeyID)
+	assert.True(t, creds.CanExpire)
+	mockSTS.AssertExpectations(t)
+
+	// The refreshed credentials are cached in turn
+	creds, err = testClient.awsConfig.Credentials.Retrieve(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "second-access-key", creds.AccessKeyID)
+	mockSTS.AssertNumberOfCalls(t, "AssumeRole", 2)
+}
+
+func TestAssumeRoleChainRefreshesLastHop(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	mockSTS := new(MockSTSAPI)
+
+	expectHop := func(roleArn, accessKey string, expires time.Time) {
+		mockSTS.On("AssumeRole", mock.Anything, mock.MatchedBy(func(input *sts.AssumeRoleInput) bool {
+			return aws.ToString(input.RoleArn) == roleArn
+		})).Return(&sts.AssumeRoleOutput{
+			Credentials: &stsTypes.Credentials{
+				AccessKeyId:     aws.String(accessKey),
+				SecretAccessKey: aws.String(accessKey + "-secret"),
+				SessionToken:    aws.String(accessKey + "-token"),
+				Expiration:      aws.Time(expires),
+			},
+			AssumedRoleUser: &stsTypes.AssumedRoleUser{Arn: aws.String(roleArn)},
+		}, nil).Once()
+	}
+	expectHop("arn:aws:iam::111111111111:role/terraform-broker", "broker-access-key", now.Add(time.Hour))
+	expectHop("arn:aws:iam::222222222222:role/AWSControlTowerExecution", "management-access-key", now.Add(time.Hour))
+	expectHop("arn:aws:iam::222222222222:role/AWSControlTowerExecution", "refreshed-management-access-key", now.Add(2*time.Hour))
+
+	testClient := &Client{
+		awsConfig: aws.Config{
+			Credentials: credentials.NewStaticCredentialsProvider("base-access-key", "base-secret-key", ""),
+		},
+		stsClient: mockSTS,
+		now:       func() time.Time { return now },
+	}
+
+	err := testClient.AssumeRoleChain(context.Background(), []*AssumeRoleConfig{
+		{RoleArn: "arn:aws:iam::111111111111:role/terraform-broker"},
+		{RoleArn: "arn:aws:iam::222222222222:role/AWSControlTowerExecution"},
+	})
+	assert.NoError(t, err)
+
+	// Only the last hop backs the client credentials, so only it is assumed
+	// again when its credentials expire
+	now = now.Add(time.Hour)
+	creds, err := testClient.awsConfig.Credentials.Retrieve(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "refreshed-management-access-key", creds.AccessKeyID)
+	mockSTS.AssertExpectations(t)
+}
+
+func TestAssumeRoleWithWebIdentityRefreshRereadsTokenFile(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	assert.NoError(t, os.WriteFile(tokenFile, []byte("first-token"), 0600))
+
+	mockSTS := new(MockSTSAPI)
+	expectToken := func(token, accessKey string, expires time.Time) {
+		mockSTS.On("AssumeRoleWithWebIdentity", mock.Anything, mock.MatchedBy(func(input *sts.AssumeRoleWithWebIdentityInput) bool {
+			return aws.ToString(input.WebIdentityToken) == token
+		})).Return(&sts.AssumeRoleWithWebIdentityOutput{
+			Credentials: &stsTypes.Credentials{
+				AccessKeyId:     aws.String(accessKey),
+				SecretAccessKey: aws.String(accessKey + "-secret"),
+				SessionToken:    aws.String(accessKey + "-token"),
+				Expiration:      aws.Time(expires),
+			},
+		}, nil).Once()
+	}
+	expectToken("first-token", "first-access-key", now.Add(time.Hour))
+	expectToken("rotated-token", "second-access-key", now.Add(2*time.Hour))
+
+	testClient := &Client{
+		stsClient: mockSTS,
+		now:       func() time.Time { return now },
+	}
+
+	err := testClient.AssumeRoleWithWebIdentity(context.Background(), &WebIdentityConfig{
+		RoleArn:              "arn:aws:iam::123456789012:role/ci",
+		WebIdentityTokenFile: tokenFile,
+	})
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(tokenFile, []byte("rotated-token"), 0600))
+	now = now.Add(58 * time.Minute)
+
+	creds, err := testClient.awsConfig.Credentials.Retrieve(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "second-access-key", creds.AccessKeyID)
+	mockSTS.AssertExpectations(t)
+}
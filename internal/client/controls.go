@@ -0,0 +1,190 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/controltower"
+	"github.com/aws/aws-sdk-go-v2/service/controltower/document"
+	ctTypes "github.com/aws/aws-sdk-go-v2/service/controltower/types"
+)
+
+// EnabledControlInfo represents a control enabled on an organizational unit
+type EnabledControlInfo struct {
+	Arn               string
+	ControlIdentifier string
+	TargetIdentifier  string
+	Status            string
+	DriftStatus       string
+
+	// Parameters maps each control parameter key to its JSON-encoded value
+	Parameters map[string]string
+}
+
+// EnableControl starts enabling a control on the target organizational unit
+// with the given JSON-encoded parameters and returns the enabled control ARN
+// and the operation identifier
+func (c *Client) EnableControl(ctx context.Context, controlIdentifier, targetIdentifier string, parameters map[string]string) (string, string, error) {
+	controlParameters, err := newEnabledControlParameters(parameters)
+	if err != nil {
+		return "", "", err
+	}
+
+	result, err := c.controlTower().EnableControl(ctx, &controltower.EnableControlInput{
+		ControlIdentifier: aws.String(controlIdentifier),
+		TargetIdentifier:  aws.String(targetIdentifier),
+		Parameters:        controlParameters,
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("failed to enable control %s on %s: %w", controlIdentifier, targetIdentifier, err)
+	}
+
+	return aws.ToString(result.Arn), aws.ToString(result.OperationIdentifier), nil
+}
+
+// UpdateEnabledControl starts replacing the parameters of an enabled control
+// and returns the operation identifier
+func (c *Client) UpdateEnabledControl(ctx context.Context, enabledControlArn string, parameters map[string]string) (string, error) {
+	controlParameters, err := newEnabledControlParameters(parameters)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := c.controlTower().UpdateEnabledControl(ctx, &controltower.UpdateEnabledControlInput{
+		EnabledControlIdentifier: aws.String(enabledControlArn),
+		Parameters:               controlParameters,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to update enabled control %s: %w", enabledControlArn, err)
+	}
+
+	return aws.ToString(result.OperationIdentifier), nil
+}
+
+// DisableControl starts disabling a control on the target organizational unit
+// and returns the operation identifier
+func (c *Client) DisableControl(ctx context.Context, controlIdentifier, targetIdentifier string) (string, error) {
+	result, err := c.controlTower().DisableControl(ctx, &controltower.DisableControlInput{
+		ControlIdentifier: aws.String(controlIdentifier),
+		TargetIdentifier:  aws.String(targetIdentifier),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to disable control %s on %s: %w", controlIdentifier, targetIdentifier, err)
+	}
+
+	return aws.ToString(result.OperationIdentifier), nil
+}
+
+// GetControlOperation retrieves the status and status message of a control operation
+func (c *Client) GetControlOperation(ctx context.Context, operationID string) (string, string, error) {
+	result, err := c.controlTower().GetControlOperation(ctx, &controltower.GetControlOperationInput{
+		OperationIdentifier: aws.String(operationID),
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get control operation: %w", err)
+	}
+	if result.ControlOperation == nil {
+		return "", "", fmt.Errorf("failed to get control operation: empty response for %s", operationID)
+	}
+
+	return string(result.ControlOperation.Status), aws.ToString(result.ControlOperation.StatusMessage), nil
+}
+
+// WaitForControlOperation blocks until the given control operation succeeds or fails
+func (c *Client) WaitForControlOperation(ctx context.Context, operationID string) error {
+	return c.waitForOperation(ctx, operationID, func(ctx context.Context) (string, string, error) {
+		return c.GetControlOperation(ctx, operationID)
+	})
+}
+
+// GetEnabledControl retrieves the enabled control with the given ARN
+func (c *Client) GetEnabledControl(ctx context.Context, enabledControlArn string) (*EnabledControlInfo, error) {
+	result, err := c.controlTower().GetEnabledControl(ctx, &controltower.GetEnabledControlInput{
+		EnabledControlIdentifier: aws.String(enabledControlArn),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get enabled control: %w", err)
+	}
+
+	details := result.EnabledControlDetails
+	if details == nil {
+		return nil, fmt.Errorf("failed to get enabled control: empty response for %s", enabledControlArn)
+	}
+
+	info := &EnabledControlInfo{
+		Arn:               aws.ToString(details.Arn),
+		ControlIdentifier: aws.ToString(details.ControlIdentifier),
+		TargetIdentifier:  aws.ToString(details.TargetIdentifier),
+	}
+	if details.StatusSummary != nil {
+		info.Status = string(details.StatusSummary.Status)
+	}
+	if details.DriftStatusSummary != nil {
+		info.DriftStatus = string(details.DriftStatusSummary.DriftStatus)
+	}
+	if len(details.Parameters) > 0 {
+		info.Parameters = make(map[string]string, len(details.Parameters))
+		for _, parameter := range details.Parameters {
+			value, err := documentToJSON(parameter.Value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read parameter %s of enabled control: %w", aws.ToString(parameter.Key), err)
+			}
+			info.Parameters[aws.ToString(parameter.Key)] = value
+		}
+	}
+
+	return info, nil
+}
+
+// FindEnabledControl looks up the control enabled on the target organizational
+// unit. A ResourceNotFoundException is returned when the control is not enabled.
+func (c *Client) FindEnabledControl(ctx context.Context, controlIdentifier, targetIdentifier string) (*EnabledControlInfo, error) {
+	var nextToken *string
+
+	for {
+		result, err := c.controlTower().ListEnabledControls(ctx, &controltower.ListEnabledControlsInput{
+			TargetIdentifier: aws.String(targetIdentifier),
+			NextToken:        nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list enabled controls for %s: %w", targetIdentifier, err)
+		}
+
+		for _, control := range result.EnabledControls {
+			if aws.ToString(control.ControlIdentifier) == controlIdentifier {
+				return c.GetEnabledControl(ctx, aws.ToString(control.Arn))
+			}
+		}
+
+		if result.NextToken == nil {
+			break
+		}
+		nextToken = result.NextToken
+	}
+
+	return nil, &ctTypes.ResourceNotFoundException{
+		Message: aws.String(fmt.Sprintf("control %s is not enabled on %s", controlIdentifier, targetIdentifier)),
+	}
+}
+
+// newEnabledControlParameters converts JSON-encoded parameter values into
+// Control Tower enabled control parameters, ordered by key
+func newEnabledControlParameters(parameters map[string]string) ([]ctTypes.EnabledControlParameter, error) {
+	if parameters == nil {
+		return nil, nil
+	}
+
+	controlParameters := make([]ctTypes.EnabledControlParameter, 0, len(parameters))
+	err := forEachJSONParameter(parameters, func(key string, value document.Interface) {
+		controlParameters = append(controlParameters, ctTypes.EnabledControlParameter{
+			Key:   aws.String(key),
+			Value: value,
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse control parameter %w", err)
+	}
+
+	return controlParameters, nil
+}
@@ -0,0 +1,185 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/controltower"
+	"github.com/aws/aws-sdk-go-v2/service/controltower/document"
+	ctTypes "github.com/aws/aws-sdk-go-v2/service/controltower/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	testControlArn        = "arn:aws:controltower:us-west-2::control/AWS-GR_RESTRICT_ROOT_USER"
+	testTargetArn         = "arn:aws:organizations::123456789012:ou/o-abcdefghij/ou-abcd-12345678"
+	testEnabledControlArn = "arn:aws:controltower:us-west-2:123456789012:enabledcontrol/ABCDEFGHIJKLMNOP"
+)
+
+func TestEnableControl(t *testing.T) {
+	mockCT := new(MockControlTowerAPI)
+
+	mockCT.On("EnableControl", mock.Anything, mock.MatchedBy(func(input *controltower.EnableControlInput) bool {
+		return aws.ToString(input.ControlIdentifier) == testControlArn && aws.ToString(input.TargetIdentifier) == testTargetArn
+	})).Return(&controltower.EnableControlOutput{
+		Arn:                 aws.String(testEnabledControlArn),
+		OperationIdentifier: aws.String("op-1"),
+	}, nil)
+
+	testClient := &Client{ctClient: mockCT}
+
+	arn, operationID, err := testClient.EnableControl(context.Background(), testControlArn, testTargetArn, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, testEnabledControlArn, arn)
+	assert.Equal(t, "op-1", operationID)
+	mockCT.AssertExpectations(t)
+}
+
+func TestEnableControlWithParameters(t *testing.T) {
+	mockCT := new(MockControlTowerAPI)
+
+	mockCT.On("EnableControl", mock.Anything, mock.MatchedBy(func(input *controltower.EnableControlInput) bool {
+		if len(input.Parameters) != 2 {
+			return false
+		}
+		raw, err := input.Parameters[0].Value.MarshalSmithyDocument()
+		return err == nil &&
+			aws.ToString(input.Parameters[0].Key) == "AllowedRegions" &&
+			string(raw) == `["us-east-1","us-west-2"]` &&
+			aws.ToString(input.Parameters[1].Key) == "ExemptedPrincipalArns"
+	})).Return(&controltower.EnableControlOutput{
+		Arn:                 aws.String(testEnabledControlArn),
+		OperationIdentifier: aws.String("op-1"),
+	}, nil)
+
+	testClient := &Client{ctClient: mockCT}
+
+	_, _, err := testClient.EnableControl(context.Background(), testControlArn, testTargetArn, map[string]string{
+		"ExemptedPrincipalArns": `["arn:aws:iam::123456789012:role/Admin"]`,
+		"AllowedRegions":        `["us-east-1", "us-west-2"]`,
+	})
+	assert.NoError(t, err)
+	mockCT.AssertExpectations(t)
+}
+
+func TestEnableControlInvalidParameter(t *testing.T) {
+	mockCT := new(MockControlTowerAPI)
+	testClient := &Client{ctClient: mockCT}
+
+	_, _, err := testClient.EnableControl(context.Background(), testControlArn, testTargetArn, map[string]string{
+		"AllowedRegions": `us-east-1`,
+	})
+	assert.ErrorContains(t, err, "AllowedRegions")
+	mockCT.AssertNotCalled(t, "EnableControl", mock.Anything, mock.Anything)
+}
+
+func TestUpdateEnabledControl(t *testing.T) {
+	mockCT := new(MockControlTowerAPI)
+
+	mockCT.On("UpdateEnabledControl", mock.Anything, mock.MatchedBy(func(input *controltower.UpdateEnabledControlInput) bool {
+		return aws.ToString(input.EnabledControlIdentifier) == testEnabledControlArn &&
+			len(input.Parameters) == 1 && aws.ToString(input.Parameters[0].Key) == "AllowedRegions"
+	})).Return(&controltower.UpdateEnabledControlOutput{
+		OperationIdentifier: aws.String("op-2"),
+	}, nil)
+
+	testClient := &Client{ctClient: mockCT}
+
+	operationID, err := testClient.UpdateEnabledControl(context.Background(), testEnabledControlArn, map[string]string{
+		"AllowedRegions": `["eu-west-1"]`,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "op-2", operationID)
+	mockCT.AssertExpectations(t)
+}
+
+func TestWaitForControlOperationFailed(t *testing.T) {
+	mockCT := new(MockControlTowerAPI)
+
+	mockCT.On("GetControlOperation", mock.Anything, mock.Anything).
+		Return(&controltower.GetControlOperationOutput{
+			ControlOperation: &ctTypes.ControlOperation{Status: ctTypes.ControlOperationStatusInProgress},
+		}, nil).Once()
+	mockCT.On("GetControlOperation", mock.Anything, mock.Anything).
+		Return(&controltower.GetControlOperationOutput{
+			ControlOperation: &ctTypes.ControlOperation{
+				Status:        ctTypes.ControlOperationStatusFailed,
+				StatusMessage: aws.String("The OU is not registered with AWS Control Tower"),
+			},
+		}, nil).Once()
+
+	testClient := &Client{ctClient: mockCT, operationPollInterval: time.Millisecond}
+
+	err := testClient.WaitForControlOperation(context.Background(), "op-1")
+	var opErr *OperationFailedError
+	assert.True(t, errors.As(err, &opErr))
+	assert.Equal(t, "The OU is not registered with AWS Control Tower", opErr.StatusMessage)
+	mockCT.AssertNumberOfCalls(t, "GetControlOperation", 2)
+}
+
+func TestFindEnabledControl(t *testing.T) {
+	mockCT := new(MockControlTowerAPI)
+
+	mockCT.On("ListEnabledControls", mock.Anything, mock.MatchedBy(func(input *controltower.ListEnabledControlsInput) bool {
+		return input.NextToken == nil
+	})).Return(&controltower.ListEnabledControlsOutput{
+		EnabledControls: []ctTypes.EnabledControlSummary{
+			{
+				Arn:               aws.String("arn:aws:controltower:us-west-2:123456789012:enabledcontrol/OTHER"),
+				ControlIdentifier: aws.String("arn:aws:controltower:us-west-2::control/AWS-GR_AUDIT_BUCKET_ENCRYPTION_ENABLED"),
+			},
+		},
+		NextToken: aws.String("page-2"),
+	}, nil)
+	mockCT.On("ListEnabledControls", mock.Anything, mock.MatchedBy(func(input *controltower.ListEnabledControlsInput) bool {
+		return aws.ToString(input.NextToken) == "page-2"
+	})).Return(&controltower.ListEnabledControlsOutput{
+		EnabledControls: []ctTypes.EnabledControlSummary{
+			{
+				Arn:               aws.String(testEnabledControlArn),
+				ControlIdentifier: aws.String(testControlArn),
+			},
+		},
+	}, nil)
+	mockCT.On("GetEnabledControl", mock.Anything, mock.MatchedBy(func(input *controltower.GetEnabledControlInput) bool {
+		return aws.ToString(input.EnabledControlIdentifier) == testEnabledControlArn
+	})).Return(&controltower.GetEnabledControlOutput{
+		EnabledControlDetails: &ctTypes.EnabledControlDetails{
+			Arn:               aws.String(testEnabledControlArn),
+			ControlIdentifier: aws.String(testControlArn),
+			TargetIdentifier:  aws.String(testTargetArn),
+			StatusSummary:     &ctTypes.EnablementStatusSummary{Status: ctTypes.EnablementStatusSucceeded},
+			Parameters: []ctTypes.EnabledControlParameterSummary{
+				{
+					Key:   aws.String("AllowedRegions"),
+					Value: document.NewLazyDocument([]string{"us-east-1"}),
+				},
+			},
+		},
+	}, nil)
+
+	testClient := &Client{ctClient: mockCT}
+
+	info, err := testClient.FindEnabledControl(context.Background(), testControlArn, testTargetArn)
+	assert.NoError(t, err)
+	assert.Equal(t, testEnabledControlArn, info.Arn)
+	assert.Equal(t, "SUCCEEDED", info.Status)
+	assert.Equal(t, map[string]string{"AllowedRegions": `["us-east-1"]`}, info.Parameters)
+	mockCT.AssertExpectations(t)
+}
+
+func TestFindEnabledControlNotFound(t *testing.T) {
+	mockCT := new(MockControlTowerAPI)
+
+	mockCT.On("ListEnabledControls", mock.Anything, mock.Anything).
+		Return(&controltower.ListEnabledControlsOutput{}, nil)
+
+	testClient := &Client{ctClient: mockCT}
+
+	_, err := testClient.FindEnabledControl(context.Background(), testControlArn, testTargetArn)
+	assert.True(t, IsNotFound(err))
+}
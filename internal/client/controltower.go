@@ -0,0 +1,140 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/controltower"
+	"github.com/aws/aws-sdk-go-v2/service/controltower/document"
+)
+
+// defaultOperationPollInterval is how often long-running Control Tower
+// operations are checked when no interval has been configured on the client.
+const defaultOperationPollInterval = 15 * time.Second
+
+// Operation statuses shared by the landing zone, control and baseline APIs
+const (
+	operationStatusSucceeded = "SUCCEEDED"
+	operationStatusFailed    = "FAILED"
+)
+
+// OperationFailedError is returned when a Control Tower operation finishes
+// with a FAILED status
+type OperationFailedError struct {
+	OperationIdentifier string
+	StatusMessage       string
+}
+
+func (e *OperationFailedError) Error() string {
+	if e.StatusMessage == "" {
+		return fmt.Sprintf("operation %s failed", e.OperationIdentifier)
+	}
+	return fmt.Sprintf("operation %s failed: %s", e.OperationIdentifier, e.StatusMessage)
+}
+
+// controlTower returns the Control Tower client, creating one from the
+// current AWS configuration when no client has been injected
+func (c *Client) controlTower() ControlTowerAPI {
+	if c.ctClient != nil {
+		return c.ctClient
+	}
+	return controltower.NewFromConfig(c.awsConfig, func(o *controltower.Options) {
+		if c.endpoints.ControlTower != "" {
+			o.BaseEndpoint = aws.String(c.endpoints.ControlTower)
+		}
+		o.APIOptions = append(o.APIOptions, c.limiters.controlTower.addMiddleware, addTraceMiddleware)
+	})
+}
+
+// operationStatusFunc returns the current status and status message of a
+// long-running operation
+type operationStatusFunc func(ctx context.Context) (status string, message string, err error)
+
+// waitForOperation polls getStatus until the operation succeeds, fails or the
+// context is cancelled
+func (c *Client) waitForOperation(ctx context.Context, operationID string, getStatus operationStatusFunc) error {
+	interval := c.operationPollInterval
+	if interval <= 0 {
+		interval = defaultOperationPollInterval
+	}
+
+	for {
+		status, message, err := getStatus(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get status of operation %s: %w", operationID, err)
+		}
+
+		switch status {
+		case operationStatusSucceeded:
+			return nil
+		case operationStatusFailed:
+			return &OperationFailedError{
+				OperationIdentifier: operationID,
+				StatusMessage:       message,
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for operation %s: %w", operationID, ctx.Err())
+		case <-time.After(interval):
+		}
+	}
+}
+
+// newDocumentFromJSON converts a JSON string into a Smithy document
+func newDocumentFromJSON(s string) (document.Interface, error) {
+	var v interface{}
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return nil, fmt.Errorf("invalid JSON document: %w", err)
+	}
+	return document.NewLazyDocument(v), nil
+}
+
+// documentToJSON converts a Smithy document into a JSON string
+func documentToJSON(doc document.Interface) (string, error) {
+	if doc == nil {
+		return "", nil
+	}
+
+	raw, err := doc.MarshalSmithyDocument()
+	if err != nil {
+		return "", fmt.Errorf("failed to decode document: %w", err)
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return "", fmt.Errorf("failed to decode document: %w", err)
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode document: %w", err)
+	}
+	return string(b), nil
+}
+
+// forEachJSONParameter decodes each JSON-encoded parameter value into a Smithy
+// document and passes it to fn in key order. The returned error is prefixed
+// with the offending key.
+func forEachJSONParameter(parameters map[string]string, fn func(key string, value document.Interface)) error {
+	keys := make([]string, 0, len(parameters))
+	for key := range parameters {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value, err := newDocumentFromJSON(parameters[key])
+		if err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+		fn(key, value)
+	}
+
+	return nil
+}
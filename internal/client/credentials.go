@@ -0,0 +1,223 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/ssocreds"
+	ssoTypes "github.com/aws/aws-sdk-go-v2/service/sso/types"
+	ssooidcTypes "github.com/aws/aws-sdk-go-v2/service/ssooidc/types"
+	stsTypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+)
+
+// credentialsExpiryWindow is how long before their expiry cached temporary
+// credentials are refreshed, so that in-flight requests never sign with
+// credentials that are about to expire
+const credentialsExpiryWindow = 5 * time.Minute
+
+// refreshingCredentialsProvider caches temporary credentials and obtains new
+// ones through refresh shortly before they expire. Chained sessions refresh
+// naturally, since each hop's STS client signs with the previous hop's
+// provider.
+type refreshingCredentialsProvider struct {
+	mu      sync.Mutex
+	creds   aws.Credentials
+	refresh func(ctx context.Context) (aws.Credentials, error)
+	now     func() time.Time
+}
+
+// newRefreshingCredentials returns a provider seeded with initial credentials
+// that calls refresh once they are within credentialsExpiryWindow of expiry
+func (c *Client) newRefreshingCredentials(initial aws.Credentials, refresh func(ctx context.Context) (aws.Credentials, error)) *refreshingCredentialsProvider {
+	return &refreshingCredentialsProvider{
+		creds:   initial,
+		refresh: refresh,
+		now:     c.clock(),
+	}
+}
+
+// Retrieve returns the cached credentials, refreshing them first when they
+// are missing or about to expire
+func (p *refreshingCredentialsProvider) Retrieve(ctx context.Context) (aws.Credentials, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.needsRefresh() {
+		return p.creds, nil
+	}
+
+	creds, err := p.refresh(ctx)
+	if err != nil {
+		return aws.Credentials{}, err
+	}
+	p.creds = creds
+
+	return creds, nil
+}
+
+// needsRefresh reports whether the cached credentials must be replaced
+func (p *refreshingCredentialsProvider) needsRefresh() bool {
+	if !p.creds.HasKeys() {
+		return true
+	}
+	if !p.creds.CanExpire {
+		return false
+	}
+	return !p.now().Add(credentialsExpiryWindow).Before(p.creds.Expires)
+}
+
+// clock returns the time source used for credential expiry, which tests
+// replace with a fake clock
+func (c *Client) clock() func() time.Time {
+	if c.now != nil {
+		return c.now
+	}
+	return time.Now
+}
+
+// newSTSCredentials converts temporary credentials returned by STS
+func newSTSCredentials(stsCredentials *stsTypes.Credentials, source string) aws.Credentials {
+	creds := aws.Credentials{
+		AccessKeyID:     aws.ToString(stsCredentials.AccessKeyId),
+		SecretAccessKey: aws.ToString(stsCredentials.SecretAccessKey),
+		SessionToken:    aws.ToString(stsCredentials.SessionToken),
+		Source:          source,
+	}
+	if stsCredentials.Expiration != nil {
+		creds.CanExpire = true
+		creds.Expires = aws.ToTime(stsCredentials.Expiration)
+	}
+	return creds
+}
+
+// SSOLoginRequiredError is returned when the credentials come from an IAM
+// Identity Center (SSO) profile whose cached token in ~/.aws/sso/cache is
+// missing, expired or no longer accepted
+type SSOLoginRequiredError struct {
+	Profile string
+	Err     error
+}
+
+func (e *SSOLoginRequiredError) Error() string {
+	return fmt.Sprintf("the SSO session of profile %q is missing or has expired: %s\n\nRun `aws sso login --profile %s` to sign in again, then retry.", e.Profile, e.Err, e.Profile)
+}
+
+func (e *SSOLoginRequiredError) Unwrap() error {
+	return e.Err
+}
+
+// usesSSOCredentials reports whether the SDK resolved the credentials from an
+// IAM Identity Center (SSO) profile
+func usesSSOCredentials(provider aws.CredentialsProvider) bool {
+	return provider != nil && aws.IsCredentialsProvider(provider, &ssocreds.Provider{})
+}
+
+// ssoTokenNotRefreshableMessage is the message of the untyped error the SDK
+// returns when an sso-session token has expired and holds no refresh token
+const ssoTokenNotRefreshableMessage = "cached SSO token is expired, or not present, and cannot be refreshed"
+
+// isSSOLoginError reports whether an SSO credential failure is fixed by
+// signing in again: the cached token is missing or expired, or the portal
+// rejected it. Other failures, e.g. the portal being unreachable or a corrupt
+// cache file, are returned unchanged.
+func isSSOLoginError(err error) bool {
+	// Legacy profiles report a missing or expired token as an InvalidTokenError
+	var invalidToken *ssocreds.InvalidTokenError
+	if errors.As(err, &invalidToken) {
+		return true
+	}
+
+	// sso-session profiles return the file error of a missing token as is
+	if errors.Is(err, fs.ErrNotExist) || strings.Contains(err.Error(), ssoTokenNotRefreshableMessage) {
+		return true
+	}
+
+	var unauthorized *ssoTypes.UnauthorizedException
+	var invalidGrant *ssooidcTypes.InvalidGrantException
+	var expiredToken *ssooidcTypes.ExpiredTokenException
+	return errors.As(err, &unauthorized) || errors.As(err, &invalidGrant) || errors.As(err, &expiredToken)
+}
+
+// credentialProcessTimeout limits how long a credential_process command may
+// run
+const credentialProcessTimeout = time.Minute
+
+// credentialProcessSource is reported in credentials obtained from a
+// credential_process command
+const credentialProcessSource = "CredentialProcess"
+
+// credentialProcessOutput is the JSON document a credential_process command
+// prints to stdout. Expiration is omitted for long-term credentials.
+type credentialProcessOutput struct {
+	Version         int        `json:"Version"`
+	AccessKeyId     string     `json:"AccessKeyId"`
+	SecretAccessKey string     `json:"SecretAccessKey"`
+	SessionToken    string     `json:"SessionToken"`
+	Expiration      *time.Time `json:"Expiration"`
+}
+
+// newCredentialProcessProvider returns a provider that runs command for
+// credentials on first use, and again whenever they are about to expire
+func (c *Client) newCredentialProcessProvider(command string) *refreshingCredentialsProvider {
+	return c.newRefreshingCredentials(aws.Credentials{}, func(ctx context.Context) (aws.Credentials, error) {
+		return runCredentialProcess(ctx, command)
+	})
+}
+
+// runCredentialProcess runs command through the shell and parses the
+// credentials it prints
+func runCredentialProcess(ctx context.Context, command string) (aws.Credentials, error) {
+	ctx, cancel := context.WithTimeout(ctx, credentialProcessTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd.exe", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+	cmd.Env = os.Environ()
+
+	out, err := cmd.Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && len(exitErr.Stderr) > 0 {
+			return aws.Credentials{}, fmt.Errorf("credential_process %q failed: %w: %s", command, err, strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return aws.Credentials{}, fmt.Errorf("credential_process %q failed: %w", command, err)
+	}
+
+	var output credentialProcessOutput
+	if err := json.Unmarshal(out, &output); err != nil {
+		return aws.Credentials{}, fmt.Errorf("failed to parse the output of credential_process %q: %w", command, err)
+	}
+	if output.Version != 1 {
+		return aws.Credentials{}, fmt.Errorf("credential_process %q returned unsupported Version %d, expected 1", command, output.Version)
+	}
+	if output.AccessKeyId == "" || output.SecretAccessKey == "" {
+		return aws.Credentials{}, fmt.Errorf("credential_process %q returned no AccessKeyId or SecretAccessKey", command)
+	}
+
+	creds := aws.Credentials{
+		AccessKeyID:     output.AccessKeyId,
+		SecretAccessKey: output.SecretAccessKey,
+		SessionToken:    output.SessionToken,
+		Source:          credentialProcessSource,
+	}
+	if output.Expiration != nil {
+		creds.CanExpire = true
+		creds.Expires = *output.Expiration
+	}
+
+	return creds, nil
+}
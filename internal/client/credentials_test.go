@@ -0,0 +1,253 @@
+package client
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/credentials/ssocreds"
+	ssoTypes "github.com/aws/aws-sdk-go-v2/service/sso/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// setUpSSOProfile writes an "sso-profile" profile backed by an sso-session to
+// the isolated shared config file, and points the SSO portal at a local
+// server that only accepts the token "cached-token". It returns the URL of the
+// local server.
+func setUpSSOProfile(t *testing.T) string {
+	isolateCredentialChain(t)
+
+	err := os.WriteFile(os.Getenv("AWS_CONFIG_FILE"), []byte(`[profile sso-profile]
+sso_session    = my-sso
+sso_account_id = 123456789012
+sso_role_name  = AWSAdministratorAccess
+region         = us-west-2
+
+[sso-session my-sso]
+sso_region    = us-east-1
+sso_start_url = https://example.awsapps.com/start
+`), 0o600)
+	assert.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("x-amz-sso_bearer_token") != "cached-token" {
+			w.Header().Set("X-Amzn-Errortype", "UnauthorizedException")
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"message":"Session token not found or invalid"}`)
+			return
+		}
+		assert.Equal(t, "123456789012", r.URL.Query().Get("account_id"))
+		assert.Equal(t, "AWSAdministratorAccess", r.URL.Query().Get("role_name"))
+		fmt.Fprintf(w, `{"roleCredentials":{"accessKeyId":"sso-access-key","secretAccessKey":"sso-secret-key","sessionToken":"sso-session-token","expiration":%d}}`,
+			time.Now().Add(time.Hour).UnixMilli())
+	}))
+	t.Cleanup(server.Close)
+	t.Setenv("AWS_ENDPOINT_URL_SSO", server.URL)
+
+	return server.URL
+}
+
+// writeSSOToken caches an SSO token for the "my-sso" session the way
+// `aws sso login` does
+func writeSSOToken(t *testing.T, accessToken string, expiresAt time.Time) {
+	hash := sha1.Sum([]byte("my-sso"))
+	cacheFile := filepath.Join(os.Getenv("HOME"), ".aws", "sso", "cache", hex.EncodeToString(hash[:])+".json")
+	assert.NoError(t, os.MkdirAll(filepath.Dir(cacheFile), 0o700))
+
+	err := os.WriteFile(cacheFile, []byte(fmt.Sprintf(`{"accessToken":%q,"expiresAt":%q}`, accessToken, expiresAt.UTC().Format(time.RFC3339))), 0o600)
+	assert.NoError(t, err)
+}
+
+func TestVerifyCredentialsSSOProfile(t *testing.T) {
+	setUpSSOProfile(t)
+	writeSSOToken(t, "cached-token", time.Now().Add(time.Hour))
+
+	testClient, err := NewClientFromConfig(context.Background(), &ClientConfig{Profile: "sso-profile"})
+	assert.NoError(t, err)
+	assert.Equal(t, "sso-profile", testClient.ssoProfile)
+	assert.NoError(t, testClient.VerifyCredentials(context.Background()))
+
+	creds, err := testClient.awsConfig.Credentials.Retrieve(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "sso-access-key", creds.AccessKeyID)
+	assert.Equal(t, "sso-session-token", creds.SessionToken)
+}
+
+func TestVerifyCredentialsSSOEndpoint(t *testing.T) {
+	endpoint := setUpSSOProfile(t)
+	writeSSOToken(t, "cached-token", time.Now().Add(time.Hour))
+	t.Setenv("AWS_ENDPOINT_URL_SSO", "")
+
+	testClient, err := NewClientFromConfig(context.Background(), &ClientConfig{
+		Profile:   "sso-profile",
+		Endpoints: Endpoints{SSO: endpoint},
+	})
+	assert.NoError(t, err)
+
+	creds, err := testClient.awsConfig.Credentials.Retrieve(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "sso-access-key", creds.AccessKeyID)
+}
+
+func TestVerifyCredentialsSSOLoginRequired(t *testing.T) {
+	tests := map[string]func(t *testing.T){
+		"missing token": func(t *testing.T) {},
+		"expired token": func(t *testing.T) {
+			writeSSOToken(t, "cached-token", time.Now().Add(-time.Hour))
+		},
+		"revoked token": func(t *testing.T) {
+			writeSSOToken(t, "revoked-token", time.Now().Add(time.Hour))
+		},
+	}
+
+	for name, cacheToken := range tests {
+		t.Run(name, func(t *testing.T) {
+			setUpSSOProfile(t)
+			cacheToken(t)
+
+			testClient, err := NewClientFromConfig(context.Background(), &ClientConfig{Profile: "sso-profile"})
+			assert.NoError(t, err)
+
+			err = testClient.VerifyCredentials(context.Background())
+			var loginErr *SSOLoginRequiredError
+			assert.True(t, errors.As(err, &loginErr))
+			assert.Equal(t, "sso-profile", loginErr.Profile)
+			assert.ErrorContains(t, err, "aws sso login --profile sso-profile")
+		})
+	}
+}
+
+func TestIsSSOLoginError(t *testing.T) {
+	tests := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"invalid token":       {err: &ssocreds.InvalidTokenError{}, expected: true},
+		"missing cache file":  {err: fmt.Errorf("failed to read cached SSO token file, %w", fs.ErrNotExist), expected: true},
+		"cannot be refreshed": {err: errors.New(ssoTokenNotRefreshableMessage), expected: true},
+		"unauthorized":        {err: fmt.Errorf("operation error SSO: GetRoleCredentials, %w", &ssoTypes.UnauthorizedException{}), expected: true},
+		"corrupt cache file":  {err: errors.New("failed to parse cached SSO token file, unexpected end of JSON input"), expected: false},
+		"portal unreachable":  {err: errors.New("operation error SSO: GetRoleCredentials, dial tcp: connection refused"), expected: false},
+		"too many requests":   {err: &ssoTypes.TooManyRequestsException{}, expected: false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, isSSOLoginError(test.err))
+		})
+	}
+}
+
+// writeCredentialProcessOutput writes a credential_process JSON document that
+// the test command "cat <file>" prints
+func writeCredentialProcessOutput(t *testing.T, file, accessKey string, expiration time.Time) {
+	err := os.WriteFile(file, []byte(fmt.Sprintf(`{
+  "Version": 1,
+  "AccessKeyId": %q,
+  "SecretAccessKey": "process-secret-key",
+  "SessionToken": "process-session-token",
+  "Expiration": %q
+}`, accessKey, expiration.UTC().Format(time.RFC3339))), 0o600)
+	assert.NoError(t, err)
+}
+
+func TestCredentialProcessRefreshesWhenExpired(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	outputFile := filepath.Join(t.TempDir(), "credentials.json")
+	writeCredentialProcessOutput(t, outputFile, "first-access-key", now.Add(time.Hour))
+
+	testClient := &Client{now: func() time.Time { return now }}
+	testClient.awsConfig.Credentials = testClient.newCredentialProcessProvider("cat " + outputFile)
+
+	creds, err := testClient.awsConfig.Credentials.Retrieve(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "first-access-key", creds.AccessKeyID)
+	assert.Equal(t, "process-session-token", creds.SessionToken)
+	assert.True(t, creds.CanExpire)
+	assert.Equal(t, now.Add(time.Hour), creds.Expires)
+
+	// The helper hands out new credentials, but the cached ones are still valid
+	writeCredentialProcessOutput(t, outputFile, "second-access-key", now.Add(2*time.Hour))
+	now = now.Add(30 * time.Minute)
+	creds, err = testClient.awsConfig.Credentials.Retrieve(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "first-access-key", creds.AccessKeyID)
+
+	now = now.Add(30 * time.Minute)
+	creds, err = testClient.awsConfig.Credentials.Retrieve(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "second-access-key", creds.AccessKeyID)
+}
+
+func TestCredentialProcessErrors(t *testing.T) {
+	tests := map[string]struct {
+		command string
+		err     string
+	}{
+		"failing command": {
+			command: "echo 'vault is sealed' >&2; exit 1",
+			err:     "vault is sealed",
+		},
+		"invalid json": {
+			command: "echo not-json",
+			err:     "failed to parse the output",
+		},
+		"unsupported version": {
+			command: `echo '{"Version": 2, "AccessKeyId": "a", "SecretAccessKey": "b"}'`,
+			err:     "unsupported Version 2",
+		},
+		"missing keys": {
+			command: `echo '{"Version": 1}'`,
+			err:     "returned no AccessKeyId or SecretAccessKey",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := runCredentialProcess(context.Background(), test.command)
+			assert.ErrorContains(t, err, test.err)
+		})
+	}
+}
+
+func TestNewClientFromConfigCredentialProcess(t *testing.T) {
+	isolateCredentialChain(t)
+	outputFile := filepath.Join(t.TempDir(), "credentials.json")
+	writeCredentialProcessOutput(t, outputFile, "process-access-key", time.Now().Add(time.Hour))
+
+	// Long-term credentials without Expiration from a shared config profile
+	err := os.WriteFile(os.Getenv("AWS_CONFIG_FILE"), []byte(`[profile vault]
+credential_process = echo '{"Version": 1, "AccessKeyId": "profile-access-key", "SecretAccessKey": "profile-secret-key"}'
+`), 0o600)
+	assert.NoError(t, err)
+
+	testClient, err := NewClientFromConfig(context.Background(), &ClientConfig{Region: "us-west-2", Profile: "vault"})
+	assert.NoError(t, err)
+	assert.NoError(t, testClient.VerifyCredentials(context.Background()))
+	creds, err := testClient.awsConfig.Credentials.Retrieve(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "profile-access-key", creds.AccessKeyID)
+	assert.False(t, creds.CanExpire)
+
+	// The provider-level command takes precedence over the profile
+	testClient, err = NewClientFromConfig(context.Background(), &ClientConfig{
+		Region:            "us-west-2",
+		Profile:           "vault",
+		CredentialProcess: "cat " + outputFile,
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, testClient.VerifyCredentials(context.Background()))
+	creds, err = testClient.awsConfig.Credentials.Retrieve(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "process-access-key", creds.AccessKeyID)
+}
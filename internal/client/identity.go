@@ -0,0 +1,171 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	orgTypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+)
+
+// CallerIdentity describes the principal behind the client credentials
+type CallerIdentity struct {
+	AccountId string
+	Arn       string
+	UserId    string
+}
+
+// GetCallerIdentity returns the account and principal the client credentials
+// resolve to, after any role assumption
+func (c *Client) GetCallerIdentity(ctx context.Context) (*CallerIdentity, error) {
+	result, err := c.sts().GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get caller identity: %w", err)
+	}
+
+	return &CallerIdentity{
+		AccountId: aws.ToString(result.Account),
+		Arn:       aws.ToString(result.Arn),
+		UserId:    aws.ToString(result.UserId),
+	}, nil
+}
+
+// AccountNotAllowedError is returned by VerifyAccountAllowed when the client
+// credentials belong to an account the provider must not operate on
+type AccountNotAllowedError struct {
+	Identity *CallerIdentity
+	// Forbidden is set when the account is in the forbidden list rather than
+	// missing from the allowed list
+	Forbidden bool
+	Allowed   []string
+}
+
+func (e *AccountNotAllowedError) Error() string {
+	if e.Forbidden {
+		return fmt.Sprintf("the credentials resolve to account %s (%s), which is listed in forbidden_account_ids", e.Identity.AccountId, e.Identity.Arn)
+	}
+	return fmt.Sprintf("the credentials resolve to account %s (%s), which is not in allowed_account_ids (%s)", e.Identity.AccountId, e.Identity.Arn, strings.Join(e.Allowed, ", "))
+}
+
+// VerifyAccountAllowed checks the account the client credentials resolve to
+// against the allowed and forbidden account IDs. An empty list does not
+// restrict the account.
+func (c *Client) VerifyAccountAllowed(ctx context.Context, allowed, forbidden []string) error {
+	if len(allowed) == 0 && len(forbidden) == 0 {
+		return nil
+	}
+
+	identity, err := c.GetCallerIdentity(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, accountID := range forbidden {
+		if identity.AccountId == accountID {
+			return &AccountNotAllowedError{Identity: identity, Forbidden: true}
+		}
+	}
+
+	if len(allowed) == 0 {
+		return nil
+	}
+	for _, accountID := range allowed {
+		if identity.AccountId == accountID {
+			return nil
+		}
+	}
+	return &AccountNotAllowedError{Identity: identity, Allowed: allowed}
+}
+
+// NotManagementAccountError is returned by VerifyManagementAccount when the
+// caller is a member account that is not a delegated administrator
+type NotManagementAccountError struct {
+	Identity            *CallerIdentity
+	OrganizationId      string
+	ManagementAccountId string
+}
+
+func (e *NotManagementAccountError) Error() string {
+	return fmt.Sprintf("the credentials resolve to account %s (%s), a member account of organization %s that is not a delegated administrator. "+
+		"Organizations and Control Tower write operations must run from the management account %s or a delegated administrator account; "+
+		"use credentials of the management account or assume a role in it",
+		e.Identity.AccountId, e.Identity.Arn, e.OrganizationId, e.ManagementAccountId)
+}
+
+// VerifyManagementAccount checks that the client credentials belong to the
+// organization management account or to a delegated administrator account
+func (c *Client) VerifyManagementAccount(ctx context.Context) error {
+	identity, err := c.GetCallerIdentity(ctx)
+	if err != nil {
+		return err
+	}
+
+	result, err := c.organizations().DescribeOrganization(ctx, &organizations.DescribeOrganizationInput{})
+	if err != nil {
+		var notInUse *orgTypes.AWSOrganizationsNotInUseException
+		if errors.As(err, &notInUse) {
+			return fmt.Errorf("the credentials resolve to account %s (%s), which is not a member of an organization", identity.AccountId, identity.Arn)
+		}
+		return fmt.Errorf("failed to describe organization: %w", err)
+	}
+	if result.Organization == nil {
+		return fmt.Errorf("failed to describe organization: empty response")
+	}
+
+	managementAccountID := aws.ToString(result.Organization.MasterAccountId)
+	if identity.AccountId == managementAccountID {
+		return nil
+	}
+
+	delegated, err := c.isDelegatedAdministrator(ctx, identity.AccountId)
+	if err != nil {
+		return err
+	}
+	if delegated {
+		return nil
+	}
+
+	return &NotManagementAccountError{
+		Identity:            identity,
+		OrganizationId:      aws.ToString(result.Organization.Id),
+		ManagementAccountId: managementAccountID,
+	}
+}
+
+// isDelegatedAdministrator reports whether accountID is registered as a
+// delegated administrator for any service. Only the management account and
+// delegated administrators may list them, so a member account that is denied
+// access is not one.
+func (c *Client) isDelegatedAdministrator(ctx context.Context, accountID string) (bool, error) {
+	var nextToken *string
+
+	for {
+		result, err := c.organizations().ListDelegatedAdministrators(ctx, &organizations.ListDelegatedAdministratorsInput{
+			NextToken: nextToken,
+		})
+		if err != nil {
+			var accessDenied *orgTypes.AccessDeniedException
+			if errors.As(err, &accessDenied) {
+				return false, nil
+			}
+			return false, fmt.Errorf("failed to list delegated administrators: %w", err)
+		}
+
+		for _, admin := range result.DelegatedAdministrators {
+			if aws.ToString(admin.Id) == accountID {
+				return true, nil
+			}
+		}
+
+		if result.NextToken == nil {
+			break
+		}
+		nextToken = result.NextToken
+	}
+
+	return false, nil
+}
@@ -0,0 +1,134 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	orgTypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestVerifyAccountAllowed(t *testing.T) {
+	mockSTS := new(MockSTSAPI)
+	mockSTS.On("GetCallerIdentity", mock.Anything, mock.Anything).Return(&sts.GetCallerIdentityOutput{
+		Account: aws.String("111111111111"),
+		Arn:     aws.String("arn:aws:sts::111111111111:assumed-role/terraform/session"),
+		UserId:  aws.String("AROAEXAMPLE:session"),
+	}, nil)
+
+	testClient := &Client{stsClient: mockSTS}
+
+	assert.NoError(t, testClient.VerifyAccountAllowed(context.Background(), []string{"222222222222", "111111111111"}, nil))
+	assert.NoError(t, testClient.VerifyAccountAllowed(context.Background(), nil, []string{"222222222222"}))
+
+	err := testClient.VerifyAccountAllowed(context.Background(), []string{"222222222222"}, nil)
+	var notAllowed *AccountNotAllowedError
+	assert.True(t, errors.As(err, &notAllowed))
+	assert.False(t, notAllowed.Forbidden)
+	assert.ErrorContains(t, err, "account 111111111111")
+	assert.ErrorContains(t, err, "not in allowed_account_ids (222222222222)")
+
+	err = testClient.VerifyAccountAllowed(context.Background(), nil, []string{"111111111111"})
+	assert.True(t, errors.As(err, &notAllowed))
+	assert.True(t, notAllowed.Forbidden)
+	assert.ErrorContains(t, err, "listed in forbidden_account_ids")
+}
+
+func TestVerifyAccountAllowedWithoutRestrictions(t *testing.T) {
+	// No lists configured, so the caller identity is never looked up
+	mockSTS := new(MockSTSAPI)
+	testClient := &Client{stsClient: mockSTS}
+
+	assert.NoError(t, testClient.VerifyAccountAllowed(context.Background(), nil, nil))
+	mockSTS.AssertNotCalled(t, "GetCallerIdentity", mock.Anything, mock.Anything)
+}
+
+func TestVerifyManagementAccount(t *testing.T) {
+	describeOrganization := func(ctx context.Context, params *organizations.DescribeOrganizationInput, optFns ...func(*organizations.Options)) (*organizations.DescribeOrganizationOutput, error) {
+		return &organizations.DescribeOrganizationOutput{
+			Organization: &orgTypes.Organization{
+				Id:              aws.String("o-abcd1234"),
+				MasterAccountId: aws.String("111111111111"),
+			},
+		}, nil
+	}
+	callerIn := func(accountID string) *MockSTSAPI {
+		mockSTS := new(MockSTSAPI)
+		mockSTS.On("GetCallerIdentity", mock.Anything, mock.Anything).Return(&sts.GetCallerIdentityOutput{
+			Account: aws.String(accountID),
+			Arn:     aws.String("arn:aws:sts::" + accountID + ":assumed-role/terraform/session"),
+		}, nil)
+		return mockSTS
+	}
+
+	t.Run("management account", func(t *testing.T) {
+		testClient := &Client{
+			stsClient: callerIn("111111111111"),
+			orgClient: &mockOrganizationsClient{DescribeOrganizationFunc: describeOrganization},
+		}
+		assert.NoError(t, testClient.VerifyManagementAccount(context.Background()))
+	})
+
+	t.Run("delegated administrator", func(t *testing.T) {
+		testClient := &Client{
+			stsClient: callerIn("222222222222"),
+			orgClient: &mockOrganizationsClient{
+				DescribeOrganizationFunc: describeOrganization,
+				ListDelegatedAdministratorsFunc: func(ctx context.Context, params *organizations.ListDelegatedAdministratorsInput, optFns ...func(*organizations.Options)) (*organizations.ListDelegatedAdministratorsOutput, error) {
+					return &organizations.ListDelegatedAdministratorsOutput{
+						DelegatedAdministrators: []orgTypes.DelegatedAdministrator{{Id: aws.String("222222222222")}},
+					}, nil
+				},
+			},
+		}
+		assert.NoError(t, testClient.VerifyManagementAccount(context.Background()))
+	})
+
+	t.Run("member account", func(t *testing.T) {
+		testClient := &Client{
+			stsClient: callerIn("333333333333"),
+			orgClient: &mockOrganizationsClient{
+				DescribeOrganizationFunc: describeOrganization,
+				ListDelegatedAdministratorsFunc: func(ctx context.Context, params *organizations.ListDelegatedAdministratorsInput, optFns ...func(*organizations.Options)) (*organizations.ListDelegatedAdministratorsOutput, error) {
+					return nil, &orgTypes.AccessDeniedException{Message: aws.String("not authorized")}
+				},
+			},
+		}
+
+		err := testClient.VerifyManagementAccount(context.Background())
+		var notManagementErr *NotManagementAccountError
+		assert.True(t, errors.As(err, &notManagementErr))
+		assert.Equal(t, "111111111111", notManagementErr.ManagementAccountId)
+		assert.ErrorContains(t, err, "account 333333333333")
+		assert.ErrorContains(t, err, "management account 111111111111")
+	})
+
+	t.Run("no organization", func(t *testing.T) {
+		testClient := &Client{
+			stsClient: callerIn("444444444444"),
+			orgClient: &mockOrganizationsClient{
+				DescribeOrganizationFunc: func(ctx context.Context, params *organizations.DescribeOrganizationInput, optFns ...func(*organizations.Options)) (*organizations.DescribeOrganizationOutput, error) {
+					return nil, &orgTypes.AWSOrganizationsNotInUseException{}
+				},
+			},
+		}
+		assert.ErrorContains(t, testClient.VerifyManagementAccount(context.Background()), "not a member of an organization")
+	})
+
+	t.Run("empty response", func(t *testing.T) {
+		testClient := &Client{
+			stsClient: callerIn("111111111111"),
+			orgClient: &mockOrganizationsClient{
+				DescribeOrganizationFunc: func(ctx context.Context, params *organizations.DescribeOrganizationInput, optFns ...func(*organizations.Options)) (*organizations.DescribeOrganizationOutput, error) {
+					return &organizations.DescribeOrganizationOutput{}, nil
+				},
+			},
+		}
+		assert.ErrorContains(t, testClient.VerifyManagementAccount(context.Background()), "failed to describe organization: empty response")
+	})
+}
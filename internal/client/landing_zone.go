@@ -0,0 +1,134 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/controltower"
+)
+
+// LandingZoneInfo represents information about a Control Tower landing zone
+type LandingZoneInfo struct {
+	Arn                    string
+	Version                string
+	LatestAvailableVersion string
+	Manifest               string
+	Status                 string
+	DriftStatus            string
+}
+
+// CreateLandingZone starts the creation of a landing zone from the given JSON
+// manifest and returns the landing zone ARN and the operation identifier
+func (c *Client) CreateLandingZone(ctx context.Context, version, manifest string) (string, string, error) {
+	doc, err := newDocumentFromJSON(manifest)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to parse landing zone manifest: %w", err)
+	}
+
+	result, err := c.controlTower().CreateLandingZone(ctx, &controltower.CreateLandingZoneInput{
+		Version:  aws.String(version),
+		Manifest: doc,
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("failed to create landing zone: %w", err)
+	}
+
+	return aws.ToString(result.Arn), aws.ToString(result.OperationIdentifier), nil
+}
+
+// GetLandingZone retrieves the landing zone with the given identifier
+func (c *Client) GetLandingZone(ctx context.Context, identifier string) (*LandingZoneInfo, error) {
+	result, err := c.controlTower().GetLandingZone(ctx, &controltower.GetLandingZoneInput{
+		LandingZoneIdentifier: aws.String(identifier),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get landing zone: %w", err)
+	}
+
+	landingZone := result.LandingZone
+	if landingZone == nil {
+		return nil, fmt.Errorf("failed to get landing zone: empty response for %s", identifier)
+	}
+
+	manifest, err := documentToJSON(landingZone.Manifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read landing zone manifest: %w", err)
+	}
+
+	info := &LandingZoneInfo{
+		Arn:                    aws.ToString(landingZone.Arn),
+		Version:                aws.ToString(landingZone.Version),
+		LatestAvailableVersion: aws.ToString(landingZone.LatestAvailableVersion),
+		Manifest:               manifest,
+		Status:                 string(landingZone.Status),
+	}
+	if landingZone.DriftStatus != nil {
+		info.DriftStatus = string(landingZone.DriftStatus.Status)
+	}
+
+	return info, nil
+}
+
+// UpdateLandingZone starts an update of the landing zone version and manifest
+// and returns the operation identifier
+func (c *Client) UpdateLandingZone(ctx context.Context, identifier, version, manifest string) (string, error) {
+	doc, err := newDocumentFromJSON(manifest)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse landing zone manifest: %w", err)
+	}
+
+	result, err := c.controlTower().UpdateLandingZone(ctx, &controltower.UpdateLandingZoneInput{
+		LandingZoneIdentifier: aws.String(identifier),
+		Version:               aws.String(version),
+		Manifest:              doc,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to update landing zone: %w", err)
+	}
+
+	return aws.ToString(result.OperationIdentifier), nil
+}
+
+// ResetLandingZone starts a reset of the landing zone to the parameters of its
+// current manifest and returns the operation identifier
+func (c *Client) ResetLandingZone(ctx context.Context, identifier string) (string, error) {
+	result, err := c.controlTower().ResetLandingZone(ctx, &controltower.ResetLandingZoneInput{
+		LandingZoneIdentifier: aws.String(identifier),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to reset landing zone: %w", err)
+	}
+
+	return aws.ToString(result.OperationIdentifier), nil
+}
+
+// DeleteLandingZone starts the decommissioning of the landing zone and returns
+// the operation identifier
+func (c *Client) DeleteLandingZone(ctx context.Context, identifier string) (string, error) {
+	result, err := c.controlTower().DeleteLandingZone(ctx, &controltower.DeleteLandingZoneInput{
+		LandingZoneIdentifier: aws.String(identifier),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to delete landing zone: %w", err)
+	}
+
+	return aws.ToString(result.OperationIdentifier), nil
+}
+
+// WaitForLandingZoneOperation blocks until the given landing zone operation
+// succeeds or fails
+func (c *Client) WaitForLandingZoneOperation(ctx context.Context, operationID string) error {
+	return c.waitForOperation(ctx, operationID, func(ctx context.Context) (string, string, error) {
+		result, err := c.controlTower().GetLandingZoneOperation(ctx, &controltower.GetLandingZoneOperationInput{
+			OperationIdentifier: aws.String(operationID),
+		})
+		if err != nil {
+			return "", "", err
+		}
+		if result.OperationDetails == nil {
+			return "", "", fmt.Errorf("empty operation details")
+		}
+		return string(result.OperationDetails.Status), aws.ToString(result.OperationDetails.StatusMessage), nil
+	})
+}
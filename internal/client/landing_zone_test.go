@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/controltower"
+	"github.com/aws/aws-sdk-go-v2/service/controltower/document"
+	ctTypes "github.com/aws/aws-sdk-go-v2/service/controltower/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateLandingZone(t *testing.T) {
+	mockCT := new(MockControlTowerAPI)
+
+	mockCT.On("CreateLandingZone", mock.Anything, mock.MatchedBy(func(input *controltower.CreateLandingZoneInput) bool {
+		return aws.ToString(input.Version) == "3.3" && input.Manifest != nil
+	})).Return(&controltower.CreateLandingZoneOutput{
+		Arn:                 aws.String("arn:aws:controltower:us-west-2:123456789012:landingzone/1A2B3C4D5E6F"),
+		OperationIdentifier: aws.String("op-1"),
+	}, nil)
+
+	testClient := &Client{ctClient: mockCT}
+
+	arn, operationID, err := testClient.CreateLandingZone(context.Background(), "3.3", `{"governedRegions":["us-west-2"]}`)
+	assert.NoError(t, err)
+	assert.Equal(t, "arn:aws:controltower:us-west-2:123456789012:landingzone/1A2B3C4D5E6F", arn)
+	assert.Equal(t, "op-1", operationID)
+	mockCT.AssertExpectations(t)
+}
+
+func TestCreateLandingZoneInvalidManifest(t *testing.T) {
+	mockCT := new(MockControlTowerAPI)
+	testClient := &Client{ctClient: mockCT}
+
+	_, _, err := testClient.CreateLandingZone(context.Background(), "3.3", `{not json`)
+	assert.Error(t, err)
+	mockCT.AssertNotCalled(t, "CreateLandingZone", mock.Anything, mock.Anything)
+}
+
+func TestGetLandingZone(t *testing.T) {
+	mockCT := new(MockControlTowerAPI)
+
+	mockCT.On("GetLandingZone", mock.Anything, mock.AnythingOfType("*controltower.GetLandingZoneInput")).
+		Return(&controltower.GetLandingZoneOutput{
+			LandingZone: &ctTypes.LandingZoneDetail{
+				Arn:                    aws.String("arn:aws:controltower:us-west-2:123456789012:landingzone/1A2B3C4D5E6F"),
+				Version:                aws.String("3.2"),
+				LatestAvailableVersion: aws.String("3.3"),
+				Manifest:               document.NewLazyDocument(map[string]interface{}{"governedRegions": []string{"us-west-2"}}),
+				Status:                 ctTypes.LandingZoneStatusActive,
+				DriftStatus: &ctTypes.LandingZoneDriftStatusSummary{
+					Status: ctTypes.LandingZoneDriftStatusInSync,
+				},
+			},
+		}, nil)
+
+	testClient := &Client{ctClient: mockCT}
+
+	info, err := testClient.GetLandingZone(context.Background(), "arn:aws:controltower:us-west-2:123456789012:landingzone/1A2B3C4D5E6F")
+	assert.NoError(t, err)
+	assert.Equal(t, "3.2", info.Version)
+	assert.Equal(t, "3.3", info.LatestAvailableVersion)
+	assert.Equal(t, "ACTIVE", info.Status)
+	assert.Equal(t, "IN_SYNC", info.DriftStatus)
+	assert.JSONEq(t, `{"governedRegions":["us-west-2"]}`, info.Manifest)
+}
+
+func TestGetLandingZoneNotFound(t *testing.T) {
+	mockCT := new(MockControlTowerAPI)
+
+	mockCT.On("GetLandingZone", mock.Anything, mock.Anything).
+		Return(nil, &ctTypes.ResourceNotFoundException{Message: aws.String("not found")})
+
+	testClient := &Client{ctClient: mockCT}
+
+	_, err := testClient.GetLandingZone(context.Background(), "missing")
+	assert.Error(t, err)
+	assert.True(t, IsNotFound(err))
+}
+
+func TestWaitForLandingZoneOperation(t *testing.T) {
+	mockCT := new(MockControlTowerAPI)
+
+	mockCT.On("GetLandingZoneOperation", mock.Anything, mock.Anything).
+		Return(&controltower.GetLandingZoneOperationOutput{
+			OperationDetails: &ctTypes.LandingZoneOperationDetail{Status: ctTypes.LandingZoneOperationStatusInProgress},
+		}, nil).Once()
+	mockCT.On("GetLandingZoneOperation", mock.Anything, mock.Anything).
+		Return(&controltower.GetLandingZoneOperationOutput{
+			OperationDetails: &ctTypes.LandingZoneOperationDetail{Status: ctTypes.LandingZoneOperationStatusSucceeded},
+		}, nil).Once()
+
+	testClient := &Client{ctClient: mockCT, operationPollInterval: time.Millisecond}
+
+	err := testClient.WaitForLandingZoneOperation(context.Background(), "op-1")
+	assert.NoError(t, err)
+	mockCT.AssertNumberOfCalls(t, "GetLandingZoneOperation", 2)
+}
+
+func TestWaitForLandingZoneOperationFailed(t *testing.T) {
+	mockCT := new(MockControlTowerAPI)
+
+	mockCT.On("GetLandingZoneOperation", mock.Anything, mock.Anything).
+		Return(&controltower.GetLandingZoneOperationOutput{
+			OperationDetails: &ctTypes.LandingZoneOperationDetail{
+				Status:        ctTypes.LandingZoneOperationStatusFailed,
+				StatusMessage: aws.String("AWSControlTowerAdmin role is missing"),
+			},
+		}, nil)
+
+	testClient := &Client{ctClient: mockCT, operationPollInterval: time.Millisecond}
+
+	err := testClient.WaitForLandingZoneOperation(context.Background(), "op-1")
+	var opErr *OperationFailedError
+	assert.True(t, errors.As(err, &opErr))
+	assert.Equal(t, "AWSControlTowerAdmin role is missing", opErr.StatusMessage)
+}
@@ -0,0 +1,69 @@
+package client
+
+import (
+	"context"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// logSubsystem is the terraform-plugin-log subsystem of the AWS client. It
+// logs at the provider level set by TF_LOG or TF_LOG_PROVIDER, which
+// logLevelEnvVar overrides for the client alone.
+const logSubsystem = "aws-client"
+
+// logLevelEnvVar sets the log level of the aws-client subsystem
+const logLevelEnvVar = "TF_LOG_PROVIDER_CONTROLTOWERMANAGEMENT_AWS_CLIENT"
+
+// logLevelEnvVars are the environment variables that set the level of the
+// aws-client subsystem, from the most to the least specific
+var logLevelEnvVars = []string{
+	logLevelEnvVar,
+	"TF_LOG_PROVIDER_CONTROLTOWERMANAGEMENT",
+	"TF_LOG_PROVIDER",
+	"TF_LOG",
+}
+
+// traceLogEnabled reports whether the aws-client subsystem logs at TRACE
+// level. terraform-plugin-log cannot be asked for its level, so this follows
+// the environment variables Terraform and the subsystem filter on.
+func traceLogEnabled() bool {
+	for _, envVar := range logLevelEnvVars {
+		if level := strings.ToUpper(os.Getenv(envVar)); level != "" {
+			return level == "TRACE" || level == "JSON"
+		}
+	}
+	return false
+}
+
+// Log field keys. The values of the credential fields are always masked.
+const (
+	logKeyAccessKeyID       = "aws_access_key_id"
+	logKeySecretAccessKey   = "aws_secret_access_key"
+	logKeySessionToken      = "aws_session_token"
+	logKeyExternalID        = "aws_external_id"
+	logKeyRoleArn           = "aws_role_arn"
+	logKeySessionName       = "aws_session_name"
+	logKeyAssumedRoleArn    = "aws_assumed_role_arn"
+	logKeyCredentialsSource = "aws_credentials_source"
+)
+
+// accessKeyIDPattern matches long-term (AKIA) and temporary (ASIA) access key
+// IDs wherever they end up in a log message or field
+var accessKeyIDPattern = regexp.MustCompile(`\b(?:AKIA|ASIA)[A-Z0-9]{16}\b`)
+
+// newLogContext returns ctx with the aws-client logging subsystem, which masks
+// credentials and external IDs
+func newLogContext(ctx context.Context) context.Context {
+	ctx = tflog.NewSubsystem(ctx, logSubsystem, tflog.WithLevelFromEnv(logLevelEnvVar))
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, logSubsystem,
+		logKeyAccessKeyID,
+		logKeySecretAccessKey,
+		logKeySessionToken,
+		logKeyExternalID,
+	)
+	ctx = tflog.SubsystemMaskAllFieldValuesRegexes(ctx, logSubsystem, accessKeyIDPattern)
+	return tflog.SubsystemMaskMessageRegexes(ctx, logSubsystem, accessKeyIDPattern)
+}
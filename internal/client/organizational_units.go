@@ -0,0 +1,221 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	orgTypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+)
+
+// OrganizationalUnitInfo represents an organizational unit in AWS Organizations
+type OrganizationalUnitInfo struct {
+	Id       string
+	Arn      string
+	Name     string
+	ParentId string
+	// Depth and Path are only populated by ListOrganizationalUnitTree
+	Depth int
+	Path  string
+}
+
+// RootInfo represents the root of an organization
+type RootInfo struct {
+	Id   string
+	Arn  string
+	Name string
+}
+
+// CreateOrganizationalUnit creates an organizational unit under the given
+// parent root or organizational unit
+func (c *Client) CreateOrganizationalUnit(ctx context.Context, parentID, name string) (*OrganizationalUnitInfo, error) {
+	result, err := c.organizations().CreateOrganizationalUnit(ctx, &organizations.CreateOrganizationalUnitInput{
+		ParentId: aws.String(parentID),
+		Name:     aws.String(name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create organizational unit %s under %s: %w", name, parentID, err)
+	}
+	if result.OrganizationalUnit == nil {
+		return nil, fmt.Errorf("failed to create organizational unit %s under %s: empty response", name, parentID)
+	}
+
+	return &OrganizationalUnitInfo{
+		Id:       aws.ToString(result.OrganizationalUnit.Id),
+		Arn:      aws.ToString(result.OrganizationalUnit.Arn),
+		Name:     aws.ToString(result.OrganizationalUnit.Name),
+		ParentId: parentID,
+	}, nil
+}
+
+// GetOrganizationalUnit retrieves the organizational unit with the given ID
+// together with the ID of its parent
+func (c *Client) GetOrganizationalUnit(ctx context.Context, ouID string) (*OrganizationalUnitInfo, error) {
+	result, err := c.organizations().DescribeOrganizationalUnit(ctx, &organizations.DescribeOrganizationalUnitInput{
+		OrganizationalUnitId: aws.String(ouID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe organizational unit %s: %w", ouID, err)
+	}
+	if result.OrganizationalUnit == nil {
+		return nil, fmt.Errorf("failed to describe organizational unit %s: empty response", ouID)
+	}
+
+	parentID, err := c.GetParentId(ctx, ouID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OrganizationalUnitInfo{
+		Id:       aws.ToString(result.OrganizationalUnit.Id),
+		Arn:      aws.ToString(result.OrganizationalUnit.Arn),
+		Name:     aws.ToString(result.OrganizationalUnit.Name),
+		ParentId: parentID,
+	}, nil
+}
+
+// RenameOrganizationalUnit changes the name of an organizational unit
+func (c *Client) RenameOrganizationalUnit(ctx context.Context, ouID, name string) error {
+	_, err := c.organizations().UpdateOrganizationalUnit(ctx, &organizations.UpdateOrganizationalUnitInput{
+		OrganizationalUnitId: aws.String(ouID),
+		Name:                 aws.String(name),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to rename organizational unit %s to %s: %w", ouID, name, err)
+	}
+	return nil
+}
+
+// DeleteOrganizationalUnit deletes an empty organizational unit
+func (c *Client) DeleteOrganizationalUnit(ctx context.Context, ouID string) error {
+	_, err := c.organizations().DeleteOrganizationalUnit(ctx, &organizations.DeleteOrganizationalUnitInput{
+		OrganizationalUnitId: aws.String(ouID),
+	})
+
+	var notEmpty *orgTypes.OrganizationalUnitNotEmptyException
+	if errors.As(err, &notEmpty) {
+		return fmt.Errorf("organizational unit %s still contains accounts or child organizational units; move or remove them before deleting it: %w", ouID, err)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete organizational unit %s: %w", ouID, err)
+	}
+	return nil
+}
+
+// GetParentId returns the ID of the root or organizational unit that directly
+// contains the given account or organizational unit
+func (c *Client) GetParentId(ctx context.Context, childID string) (string, error) {
+	parent, err := c.getParent(ctx, childID)
+	if err != nil {
+		return "", err
+	}
+	return aws.ToString(parent.Id), nil
+}
+
+// getParent returns the root or organizational unit that directly contains
+// the given account or organizational unit
+func (c *Client) getParent(ctx context.Context, childID string) (*orgTypes.Parent, error) {
+	result, err := c.organizations().ListParents(ctx, &organizations.ListParentsInput{
+		ChildId: aws.String(childID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list parents of %s: %w", childID, err)
+	}
+	if len(result.Parents) == 0 {
+		return nil, fmt.Errorf("failed to list parents of %s: no parent found", childID)
+	}
+
+	return &result.Parents[0], nil
+}
+
+// GetRoot returns the root of the organization
+func (c *Client) GetRoot(ctx context.Context) (*RootInfo, error) {
+	result, err := c.organizations().ListRoots(ctx, &organizations.ListRootsInput{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roots: %w", err)
+	}
+	if len(result.Roots) == 0 {
+		return nil, fmt.Errorf("failed to list roots: no root found")
+	}
+
+	root := result.Roots[0]
+	return &RootInfo{
+		Id:   aws.ToString(root.Id),
+		Arn:  aws.ToString(root.Arn),
+		Name: aws.ToString(root.Name),
+	}, nil
+}
+
+// ListOrganizationalUnitTree walks the organization from the root and returns
+// every organizational unit in depth-first order. Depth is 1 for units
+// directly under the root and Path joins the names from the root down, e.g.
+// "Root/Workloads/Prod".
+func (c *Client) ListOrganizationalUnitTree(ctx context.Context) (*RootInfo, []OrganizationalUnitInfo, error) {
+	root, err := c.GetRoot(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var units []OrganizationalUnitInfo
+	if err := c.walkOrganizationalUnits(ctx, root.Id, root.Name, 1, &units); err != nil {
+		return nil, nil, err
+	}
+
+	return root, units, nil
+}
+
+// walkOrganizationalUnits appends the organizational units below parentID,
+// and recursively their children, to units
+func (c *Client) walkOrganizationalUnits(ctx context.Context, parentID, parentPath string, depth int, units *[]OrganizationalUnitInfo) error {
+	children, err := c.ListOrganizationalUnitsForParent(ctx, parentID)
+	if err != nil {
+		return err
+	}
+
+	for _, child := range children {
+		child.Depth = depth
+		child.Path = parentPath + "/" + child.Name
+		*units = append(*units, child)
+
+		if err := c.walkOrganizationalUnits(ctx, child.Id, child.Path, depth+1, units); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ListOrganizationalUnitsForParent returns the organizational units directly
+// under the given root or organizational unit
+func (c *Client) ListOrganizationalUnitsForParent(ctx context.Context, parentID string) ([]OrganizationalUnitInfo, error) {
+	var units []OrganizationalUnitInfo
+	var nextToken *string
+
+	for {
+		result, err := c.organizations().ListOrganizationalUnitsForParent(ctx, &organizations.ListOrganizationalUnitsForParentInput{
+			ParentId:  aws.String(parentID),
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list organizational units under %s: %w", parentID, err)
+		}
+
+		for _, ou := range result.OrganizationalUnits {
+			units = append(units, OrganizationalUnitInfo{
+				Id:       aws.ToString(ou.Id),
+				Arn:      aws.ToString(ou.Arn),
+				Name:     aws.ToString(ou.Name),
+				ParentId: parentID,
+			})
+		}
+
+		if result.NextToken == nil {
+			break
+		}
+		nextToken = result.NextToken
+	}
+
+	return units, nil
+}
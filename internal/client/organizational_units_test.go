@@ -0,0 +1,143 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	orgTypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateOrganizationalUnit(t *testing.T) {
+	mockClient := &mockOrganizationsClient{
+		CreateOrganizationalUnitFunc: func(ctx context.Context, params *organizations.CreateOrganizationalUnitInput, optFns ...func(*organizations.Options)) (*organizations.CreateOrganizationalUnitOutput, error) {
+			assert.Equal(t, "r-abcd", aws.ToString(params.ParentId))
+			assert.Equal(t, "Workloads", aws.ToString(params.Name))
+			return &organizations.CreateOrganizationalUnitOutput{
+				OrganizationalUnit: &orgTypes.OrganizationalUnit{
+					Id:   aws.String("ou-abcd-12345678"),
+					Arn:  aws.String("arn:aws:organizations::123456789012:ou/o-abcdefghij/ou-abcd-12345678"),
+					Name: aws.String("Workloads"),
+				},
+			}, nil
+		},
+	}
+
+	testClient := &Client{orgClient: mockClient}
+
+	ou, err := testClient.CreateOrganizationalUnit(context.Background(), "r-abcd", "Workloads")
+	assert.NoError(t, err)
+	assert.Equal(t, "ou-abcd-12345678", ou.Id)
+	assert.Equal(t, "r-abcd", ou.ParentId)
+}
+
+func TestGetOrganizationalUnit(t *testing.T) {
+	mockClient := &mockOrganizationsClient{
+		DescribeOrganizationalUnitFunc: func(ctx context.Context, params *organizations.DescribeOrganizationalUnitInput, optFns ...func(*organizations.Options)) (*organizations.DescribeOrganizationalUnitOutput, error) {
+			return &organizations.DescribeOrganizationalUnitOutput{
+				OrganizationalUnit: &orgTypes.OrganizationalUnit{
+					Id:   aws.String("ou-abcd-12345678"),
+					Arn:  aws.String("arn:aws:organizations::123456789012:ou/o-abcdefghij/ou-abcd-12345678"),
+					Name: aws.String("Prod"),
+				},
+			}, nil
+		},
+		ListParentsFunc: func(ctx context.Context, params *organizations.ListParentsInput, optFns ...func(*organizations.Options)) (*organizations.ListParentsOutput, error) {
+			return &organizations.ListParentsOutput{
+				Parents: []orgTypes.Parent{
+					{Id: aws.String("ou-abcd-87654321"), Type: orgTypes.ParentTypeOrganizationalUnit},
+				},
+			}, nil
+		},
+	}
+
+	testClient := &Client{orgClient: mockClient}
+
+	ou, err := testClient.GetOrganizationalUnit(context.Background(), "ou-abcd-12345678")
+	assert.NoError(t, err)
+	assert.Equal(t, "Prod", ou.Name)
+	assert.Equal(t, "ou-abcd-87654321", ou.ParentId)
+}
+
+func TestGetOrganizationalUnitNotFound(t *testing.T) {
+	mockClient := &mockOrganizationsClient{
+		DescribeOrganizationalUnitFunc: func(ctx context.Context, params *organizations.DescribeOrganizationalUnitInput, optFns ...func(*organizations.Options)) (*organizations.DescribeOrganizationalUnitOutput, error) {
+			return nil, &orgTypes.OrganizationalUnitNotFoundException{Message: aws.String("not found")}
+		},
+	}
+
+	testClient := &Client{orgClient: mockClient}
+
+	_, err := testClient.GetOrganizationalUnit(context.Background(), "ou-abcd-12345678")
+	assert.True(t, IsNotFound(err))
+}
+
+func TestDeleteOrganizationalUnitNotEmpty(t *testing.T) {
+	mockClient := &mockOrganizationsClient{
+		DeleteOrganizationalUnitFunc: func(ctx context.Context, params *organizations.DeleteOrganizationalUnitInput, optFns ...func(*organizations.Options)) (*organizations.DeleteOrganizationalUnitOutput, error) {
+			return nil, &orgTypes.OrganizationalUnitNotEmptyException{Message: aws.String("The specified OU is not empty")}
+		},
+	}
+
+	testClient := &Client{orgClient: mockClient}
+
+	err := testClient.DeleteOrganizationalUnit(context.Background(), "ou-abcd-12345678")
+	assert.ErrorContains(t, err, "still contains accounts or child organizational units")
+}
+
+func TestListOrganizationalUnitTree(t *testing.T) {
+	children := map[string][]orgTypes.OrganizationalUnit{
+		"r-abcd": {
+			{Id: aws.String("ou-abcd-11111111"), Name: aws.String("Security")},
+			{Id: aws.String("ou-abcd-22222222"), Name: aws.String("Workloads")},
+		},
+		"ou-abcd-22222222": {
+			{Id: aws.String("ou-abcd-33333333"), Name: aws.String("Prod")},
+		},
+	}
+
+	mockClient := &mockOrganizationsClient{
+		ListRootsFunc: func(ctx context.Context, params *organizations.ListRootsInput, optFns ...func(*organizations.Options)) (*organizations.ListRootsOutput, error) {
+			return &organizations.ListRootsOutput{
+				Roots: []orgTypes.Root{{Id: aws.String("r-abcd"), Name: aws.String("Root")}},
+			}, nil
+		},
+		ListOrganizationalUnitsForParentFunc: func(ctx context.Context, params *organizations.ListOrganizationalUnitsForParentInput, optFns ...func(*organizations.Options)) (*organizations.ListOrganizationalUnitsForParentOutput, error) {
+			// Return the children one page at a time to exercise pagination
+			units := children[aws.ToString(params.ParentId)]
+			start := 0
+			if params.NextToken != nil {
+				start = 1
+			}
+			if start >= len(units) {
+				return &organizations.ListOrganizationalUnitsForParentOutput{}, nil
+			}
+			output := &organizations.ListOrganizationalUnitsForParentOutput{
+				OrganizationalUnits: units[start : start+1],
+			}
+			if start+1 < len(units) {
+				output.NextToken = aws.String("next")
+			}
+			return output, nil
+		},
+	}
+
+	testClient := &Client{orgClient: mockClient}
+
+	root, units, err := testClient.ListOrganizationalUnitTree(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "r-abcd", root.Id)
+	assert.Len(t, units, 3)
+
+	assert.Equal(t, "Root/Security", units[0].Path)
+	assert.Equal(t, 1, units[0].Depth)
+	assert.Equal(t, "r-abcd", units[0].ParentId)
+
+	assert.Equal(t, "Root/Workloads", units[1].Path)
+
+	assert.Equal(t, "Root/Workloads/Prod", units[2].Path)
+	assert.Equal(t, 2, units[2].Depth)
+	assert.Equal(t, "ou-abcd-22222222", units[2].ParentId)
+}
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+// partition describes an AWS partition and the regions that belong to it
+type partition struct {
+	id          string
+	regionRegex *regexp.Regexp
+}
+
+// partitions mirrors the partition metadata of the AWS SDK endpoint rules,
+// which the SDK keeps in an internal package
+var partitions = []partition{
+	{id: "aws", regionRegex: regexp.MustCompile(`^(us|eu|ap|sa|ca|me|af|il|mx)\-\w+\-\d+$`)},
+	{id: "aws-cn", regionRegex: regexp.MustCompile(`^cn\-\w+\-\d+$`)},
+	{id: "aws-us-gov", regionRegex: regexp.MustCompile(`^us\-gov\-\w+\-\d+$`)},
+	{id: "aws-iso", regionRegex: regexp.MustCompile(`^us\-iso\-\w+\-\d+$`)},
+	{id: "aws-iso-b", regionRegex: regexp.MustCompile(`^us\-isob\-\w+\-\d+$`)},
+	{id: "aws-iso-e", regionRegex: regexp.MustCompile(`^eu\-isoe\-\w+\-\d+$`)},
+	{id: "aws-iso-f", regionRegex: regexp.MustCompile(`^us\-isof\-\w+\-\d+$`)},
+}
+
+// PartitionForRegion returns the AWS partition (aws, aws-cn, aws-us-gov,
+// aws-iso, ...) that region belongs to, and false when region matches no
+// partition
+func PartitionForRegion(region string) (string, bool) {
+	for _, p := range partitions {
+		if p.regionRegex.MatchString(region) {
+			return p.id, true
+		}
+	}
+	return "", false
+}
+
+// Partition returns the AWS partition of the region the client is configured
+// with, for constructing ARNs. Unknown regions resolve to the aws partition.
+func (c *Client) Partition() string {
+	if partition, ok := PartitionForRegion(c.awsConfig.Region); ok {
+		return partition
+	}
+	return "aws"
+}
+
+// VerifyRegion checks that the client region belongs to a known partition
+func (c *Client) VerifyRegion() error {
+	if _, ok := PartitionForRegion(c.awsConfig.Region); !ok {
+		return fmt.Errorf("region %s does not belong to any AWS partition", c.awsConfig.Region)
+	}
+	return nil
+}
+
+// VerifyRoleArn checks that an ARN is an IAM role ARN in the same partition
+// as the client region, since credentials and roles never cross partitions
+func (c *Client) VerifyRoleArn(roleArn string) error {
+	parsed, err := arn.Parse(roleArn)
+	if err != nil {
+		return fmt.Errorf("invalid ARN %s: %w", roleArn, err)
+	}
+
+	if partition := c.Partition(); parsed.Partition != partition {
+		return fmt.Errorf("ARN %s is in partition %s, but region %s is in partition %s", roleArn, parsed.Partition, c.awsConfig.Region, partition)
+	}
+	if !strings.HasPrefix(roleArn, "arn:"+parsed.Partition+":iam::") || !strings.HasPrefix(parsed.Resource, "role/") {
+		return fmt.Errorf("ARN %s is not an IAM role ARN (arn:%s:iam::<account_id>:role/<name>)", roleArn, parsed.Partition)
+	}
+	return nil
+}
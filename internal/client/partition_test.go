@@ -0,0 +1,49 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPartitionForRegion(t *testing.T) {
+	tests := map[string]string{
+		"us-west-2":      "aws",
+		"mx-central-1":   "aws",
+		"ap-southeast-5": "aws",
+		"us-gov-west-1":  "aws-us-gov",
+		"cn-north-1":     "aws-cn",
+		"us-iso-east-1":  "aws-iso",
+		"us-isob-east-1": "aws-iso-b",
+	}
+
+	for region, expected := range tests {
+		partition, ok := PartitionForRegion(region)
+		assert.True(t, ok, region)
+		assert.Equal(t, expected, partition, region)
+	}
+
+	for _, region := range []string{"xx-foo-9", "us-west", "local"} {
+		_, ok := PartitionForRegion(region)
+		assert.False(t, ok, region)
+	}
+}
+
+func TestVerifyRegion(t *testing.T) {
+	assert.NoError(t, (&Client{awsConfig: aws.Config{Region: "us-gov-west-1"}}).VerifyRegion())
+	assert.ErrorContains(t, (&Client{awsConfig: aws.Config{Region: "xx-foo-9"}}).VerifyRegion(),
+		"region xx-foo-9 does not belong to any AWS partition")
+}
+
+func TestVerifyRoleArn(t *testing.T) {
+	testClient := &Client{awsConfig: aws.Config{Region: "us-gov-west-1"}}
+
+	assert.NoError(t, testClient.VerifyRoleArn("arn:aws-us-gov:iam::123456789012:role/terraform"))
+	assert.NoError(t, testClient.VerifyRoleArn("arn:aws-us-gov:iam::123456789012:role/path/terraform"))
+	assert.ErrorContains(t, testClient.VerifyRoleArn("arn:aws:iam::123456789012:role/terraform"),
+		"is in partition aws, but region us-gov-west-1 is in partition aws-us-gov")
+	assert.ErrorContains(t, testClient.VerifyRoleArn("arn:aws-us-gov:iam::123456789012:user/terraform"), "is not an IAM role ARN")
+	assert.ErrorContains(t, testClient.VerifyRoleArn("arn:aws-us-gov:s3:::role/terraform"), "is not an IAM role ARN")
+	assert.ErrorContains(t, testClient.VerifyRoleArn("not-an-arn"), "invalid ARN")
+}
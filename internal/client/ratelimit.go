@@ -0,0 +1,120 @@
+package client
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/aws/smithy-go/middleware"
+)
+
+// RateLimits caps the requests per second sent to each service. The limits
+// are shared by every resource and data source using the client, regardless
+// of Terraform parallelism. Zero means unlimited.
+type RateLimits struct {
+	Organizations  float64
+	STS            float64
+	ControlTower   float64
+	ServiceCatalog float64
+	CloudFormation float64
+}
+
+// rateLimiters holds the limiter of each service, nil when unlimited
+type rateLimiters struct {
+	organizations  *rateLimiter
+	sts            *rateLimiter
+	controlTower   *rateLimiter
+	serviceCatalog *rateLimiter
+	cloudFormation *rateLimiter
+}
+
+func newRateLimiters(limits RateLimits) rateLimiters {
+	return rateLimiters{
+		organizations:  newRateLimiter(limits.Organizations),
+		sts:            newRateLimiter(limits.STS),
+		controlTower:   newRateLimiter(limits.ControlTower),
+		serviceCatalog: newRateLimiter(limits.ServiceCatalog),
+		cloudFormation: newRateLimiter(limits.CloudFormation),
+	}
+}
+
+// rateLimiter is a token bucket refilled at rate tokens per second, holding
+// up to one second worth of tokens
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+// newRateLimiter returns a limiter for rate requests per second, or nil when
+// rate is not positive
+func newRateLimiter(rate float64) *rateLimiter {
+	if rate <= 0 {
+		return nil
+	}
+
+	burst := math.Max(1, rate)
+	return &rateLimiter{
+		rate:   rate,
+		burst:  burst,
+		tokens: burst,
+		now:    time.Now,
+		sleep:  sleepContext,
+	}
+}
+
+// Wait blocks until a request may be sent. Waiting callers reserve their
+// token up front, so they are served in order.
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := l.now()
+	if !l.last.IsZero() {
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	}
+	l.last = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		l.mu.Unlock()
+		return nil
+	}
+	wait := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.mu.Unlock()
+
+	return l.sleep(ctx, wait)
+}
+
+// addMiddleware makes every attempt of an operation, retries included, wait
+// for the limiter. It does nothing on a nil limiter.
+func (l *rateLimiter) addMiddleware(stack *middleware.Stack) error {
+	if l == nil {
+		return nil
+	}
+
+	return stack.Finalize.Insert(middleware.FinalizeMiddlewareFunc("RateLimit",
+		func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+			if err := l.Wait(ctx); err != nil {
+				return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+			}
+			return next.HandleFinalize(ctx, in)
+		}), "Retry", middleware.After)
+}
+
+// sleepContext sleeps for d or until ctx is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
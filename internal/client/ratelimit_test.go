@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiterWait(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	var waits []time.Duration
+
+	limiter := newRateLimiter(2)
+	limiter.now = func() time.Time { return now }
+	limiter.sleep = func(ctx context.Context, d time.Duration) error {
+		waits = append(waits, d)
+		return nil
+	}
+
+	// The bucket starts full with one second worth of tokens
+	assert.NoError(t, limiter.Wait(context.Background()))
+	assert.NoError(t, limiter.Wait(context.Background()))
+	assert.Empty(t, waits)
+
+	// Queued callers reserve consecutive slots
+	assert.NoError(t, limiter.Wait(context.Background()))
+	assert.NoError(t, limiter.Wait(context.Background()))
+	assert.Equal(t, []time.Duration{500 * time.Millisecond, time.Second}, waits)
+
+	// Tokens refill over time, up to the burst
+	waits = nil
+	now = now.Add(time.Minute)
+	assert.NoError(t, limiter.Wait(context.Background()))
+	assert.NoError(t, limiter.Wait(context.Background()))
+	assert.Empty(t, waits)
+}
+
+func TestNewRateLimiterUnlimited(t *testing.T) {
+	assert.Nil(t, newRateLimiter(0))
+
+	// A nil limiter adds no middleware
+	var limiter *rateLimiter
+	assert.NoError(t, limiter.addMiddleware(nil))
+}
+
+func TestRateLimitSharedAcrossCallers(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		fmt.Fprint(w, `{"Account":{"Id":"111111111111","Status":"ACTIVE"}}`)
+	}))
+	defer server.Close()
+
+	testClient, err := NewClientFromConfig(context.Background(), &ClientConfig{
+		AccessKey:  "test-access-key",
+		SecretKey:  "test-secret-key",
+		Region:     "us-east-1",
+		Endpoints:  Endpoints{Organizations: server.URL},
+		RateLimits: RateLimits{Organizations: 20},
+	})
+	assert.NoError(t, err)
+
+	// 30 concurrent calls, like resources applied in parallel: 20 fit in the
+	// initial burst and the other 10 are spread over half a second
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 30; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := testClient.DescribeAccount(context.Background(), "111111111111")
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(30), requests.Load())
+	assert.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond)
+}
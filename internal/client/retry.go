@@ -0,0 +1,47 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+)
+
+// Retry modes supported by ClientConfig.RetryMode
+const (
+	RetryModeStandard = "standard"
+	RetryModeAdaptive = "adaptive"
+)
+
+// newRetryer returns a function creating the retryer described by
+// clientConfig, or nil when the SDK defaults apply. The retryer is shared by
+// every service client created from the AWS config.
+func newRetryer(clientConfig *ClientConfig) (func() aws.Retryer, error) {
+	if clientConfig.MaxAttempts == 0 && clientConfig.RetryMode == "" && clientConfig.MaxBackoff == 0 {
+		return nil, nil
+	}
+
+	standardOptions := func(o *retry.StandardOptions) {
+		if clientConfig.MaxAttempts > 0 {
+			o.MaxAttempts = clientConfig.MaxAttempts
+		}
+		if clientConfig.MaxBackoff > 0 {
+			o.MaxBackoff = clientConfig.MaxBackoff
+		}
+	}
+
+	switch clientConfig.RetryMode {
+	case "", RetryModeStandard:
+		return func() aws.Retryer {
+			return retry.NewStandard(standardOptions)
+		}, nil
+	case RetryModeAdaptive:
+		return func() aws.Retryer {
+			return retry.NewAdaptiveMode(func(o *retry.AdaptiveModeOptions) {
+				o.StandardOptions = append(o.StandardOptions, standardOptions)
+			})
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported retry mode %q, expected %q or %q", clientConfig.RetryMode, RetryModeStandard, RetryModeAdaptive)
+	}
+}
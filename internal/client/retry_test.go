@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newThrottlingServer returns an Organizations stand-in that answers the first
+// throttles requests with TooManyRequestsException, and counts all requests
+func newThrottlingServer(t *testing.T, throttles int32) (*httptest.Server, *atomic.Int32) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		if requests.Add(1) <= throttles {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"__type":"TooManyRequestsException","Message":"Rate exceeded"}`)
+			return
+		}
+		fmt.Fprint(w, `{"Accounts":[{"Id":"111111111111","Name":"management","Status":"ACTIVE"}]}`)
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func newRetryTestClient(t *testing.T, endpoint string, clientConfig ClientConfig) *Client {
+	clientConfig.AccessKey = "test-access-key"
+	clientConfig.SecretKey = "test-secret-key"
+	clientConfig.Region = "us-east-1"
+	clientConfig.Endpoints = Endpoints{Organizations: endpoint}
+
+	testClient, err := NewClientFromConfig(context.Background(), &clientConfig)
+	assert.NoError(t, err)
+	return testClient
+}
+
+func TestRetryThrottledRequests(t *testing.T) {
+	// Adaptive mode also slows down client-side after each throttle, which
+	// takes seconds of real time, so it is only throttled once
+	tests := map[string]struct {
+		throttles int32
+	}{
+		RetryModeStandard: {throttles: 4},
+		RetryModeAdaptive: {throttles: 1},
+	}
+
+	for mode, test := range tests {
+		t.Run(mode, func(t *testing.T) {
+			server, requests := newThrottlingServer(t, test.throttles)
+			testClient := newRetryTestClient(t, server.URL, ClientConfig{
+				MaxAttempts: 5,
+				RetryMode:   mode,
+				MaxBackoff:  time.Millisecond,
+			})
+
+			accounts, err := testClient.GetAccountInfo(context.Background())
+			assert.NoError(t, err)
+			assert.Len(t, accounts, 1)
+			assert.Equal(t, test.throttles+1, requests.Load())
+		})
+	}
+}
+
+func TestRetryGivesUpAfterMaxAttempts(t *testing.T) {
+	server, requests := newThrottlingServer(t, 10)
+	testClient := newRetryTestClient(t, server.URL, ClientConfig{
+		MaxAttempts: 3,
+		MaxBackoff:  time.Millisecond,
+	})
+
+	_, err := testClient.GetAccountInfo(context.Background())
+	assert.ErrorContains(t, err, "TooManyRequestsException")
+	assert.ErrorContains(t, err, "exceeded maximum number of attempts, 3")
+	assert.Equal(t, int32(3), requests.Load())
+}
+
+func TestNewClientFromConfigInvalidRetryMode(t *testing.T) {
+	_, err := NewClientFromConfig(context.Background(), &ClientConfig{Region: "us-east-1", RetryMode: "legacy"})
+	assert.ErrorContains(t, err, `unsupported retry mode "legacy"`)
+}
@@ -0,0 +1,166 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Log field keys of AWS request traces
+const (
+	logKeyService      = "aws_service"
+	logKeyOperation    = "aws_operation"
+	logKeyRequestID    = "aws_request_id"
+	logKeyLatency      = "aws_latency_ms"
+	logKeyRetries      = "aws_retries"
+	logKeyStatusCode   = "http_status_code"
+	logKeyRequestBody  = "http_request_body"
+	logKeyResponseBody = "http_response_body"
+	logKeyError        = "error"
+)
+
+// requestIDHeaders are the response headers AWS services return the request
+// ID in
+var requestIDHeaders = []string{"X-Amzn-Requestid", "X-Amz-Requestid"}
+
+// redactedBodyFields are the request and response members whose values are
+// replaced with *** before a body is logged
+var redactedBodyFields = strings.Join([]string{
+	"AccessKeyId",
+	"SecretAccessKey",
+	"SessionToken",
+	"ExternalId",
+	"WebIdentityToken",
+	"SAMLAssertion",
+	"AccessToken",
+}, "|")
+
+// bodyRedactions replace the redacted members in the JSON, XML and form
+// encoded bodies used by the AWS protocols
+var bodyRedactions = []struct {
+	pattern     *regexp.Regexp
+	replacement string
+}{
+	{regexp.MustCompile(`(?i)("(?:` + redactedBodyFields + `)"\s*:\s*)"(?:[^"\\]|\\.)*"`), `${1}"***"`},
+	{regexp.MustCompile(`(?i)(<(?:` + redactedBodyFields + `)>)[^<]*(</)`), `${1}***${2}`},
+	{regexp.MustCompile(`(?i)((?:^|&)(?:` + redactedBodyFields + `)=)[^&]*`), `${1}***`},
+}
+
+// redactBody replaces credentials and other secrets in a request or response
+// body
+func redactBody(body []byte) string {
+	s := string(body)
+	for _, r := range bodyRedactions {
+		s = r.pattern.ReplaceAllString(s, r.replacement)
+	}
+	return s
+}
+
+// addTraceMiddleware logs every operation of a service client at TRACE level
+// with its request ID, latency and number of retries, and every attempt with
+// its redacted request and response bodies
+func addTraceMiddleware(stack *middleware.Stack) error {
+	err := stack.Initialize.Add(middleware.InitializeMiddlewareFunc("TraceOperation", traceOperation), middleware.After)
+	if err != nil {
+		return err
+	}
+	return stack.Deserialize.Add(middleware.DeserializeMiddlewareFunc("TraceHTTPBody", traceHTTPBody), middleware.After)
+}
+
+// traceOperation logs an operation once all its attempts are done. It sets up
+// the logging subsystem once per operation for traceHTTPBody to log with.
+func traceOperation(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	ctx = newLogContext(ctx)
+	ctx = middleware.WithStackValue(ctx, traceBodiesKey{}, traceLogEnabled())
+
+	start := time.Now()
+	out, metadata, err := next.HandleInitialize(ctx, in)
+
+	fields := map[string]interface{}{
+		logKeyService:   awsmiddleware.GetServiceID(ctx),
+		logKeyOperation: awsmiddleware.GetOperationName(ctx),
+		logKeyLatency:   time.Since(start).Milliseconds(),
+		logKeyRetries:   0,
+	}
+	if requestID, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+		fields[logKeyRequestID] = requestID
+	}
+	if attempts, ok := retry.GetAttemptResults(metadata); ok && len(attempts.Results) > 1 {
+		fields[logKeyRetries] = len(attempts.Results) - 1
+	}
+	if err != nil {
+		fields[logKeyError] = err.Error()
+	}
+	tflog.SubsystemTrace(ctx, logSubsystem, "AWS operation completed", fields)
+
+	return out, metadata, err
+}
+
+// traceBodiesKey is the stack value key recording whether traceHTTPBody reads
+// and logs bodies
+type traceBodiesKey struct{}
+
+// traceHTTPBody logs the redacted request and response bodies of an attempt.
+// It sits next to the transport, so it sees the signed request and the
+// response before it is deserialized. Bodies are only buffered when TRACE
+// logging is enabled.
+func traceHTTPBody(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (middleware.DeserializeOutput, middleware.Metadata, error) {
+	if enabled, _ := middleware.GetStackValue(ctx, traceBodiesKey{}).(bool); !enabled {
+		return next.HandleDeserialize(ctx, in)
+	}
+
+	fields := map[string]interface{}{
+		logKeyService:   awsmiddleware.GetServiceID(ctx),
+		logKeyOperation: awsmiddleware.GetOperationName(ctx),
+	}
+
+	if req, ok := in.Request.(*smithyhttp.Request); ok && req.GetStream() != nil {
+		body, err := io.ReadAll(req.GetStream())
+		if err != nil {
+			return middleware.DeserializeOutput{}, middleware.Metadata{}, fmt.Errorf("failed to read request body: %w", err)
+		}
+		if in.Request, err = req.SetStream(bytes.NewReader(body)); err != nil {
+			return middleware.DeserializeOutput{}, middleware.Metadata{}, fmt.Errorf("failed to reset request body: %w", err)
+		}
+		tflog.SubsystemTrace(ctx, logSubsystem, "Sending AWS request", fields, map[string]interface{}{
+			logKeyRequestBody: redactBody(body),
+		})
+	}
+
+	out, metadata, err := next.HandleDeserialize(ctx, in)
+
+	resp, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok || resp.Body == nil {
+		return out, metadata, err
+	}
+	body, readErr := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if readErr != nil {
+		return out, metadata, fmt.Errorf("failed to read response body: %w", readErr)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	responseFields := map[string]interface{}{
+		logKeyStatusCode:   resp.StatusCode,
+		logKeyResponseBody: redactBody(body),
+	}
+	for _, header := range requestIDHeaders {
+		if requestID := resp.Header.Get(header); requestID != "" {
+			responseFields[logKeyRequestID] = requestID
+			break
+		}
+	}
+	tflog.SubsystemTrace(ctx, logSubsystem, "Received AWS response", fields, responseFields)
+
+	return out, metadata, err
+}
@@ -0,0 +1,134 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactBody(t *testing.T) {
+	tests := map[string]struct {
+		body     string
+		expected string
+	}{
+		"json": {
+			body:     `{"roleCredentials":{"accessKeyId":"ASIAEXAMPLE","secretAccessKey":"se\"cret","expiration":1}}`,
+			expected: `{"roleCredentials":{"accessKeyId":"***","secretAccessKey":"***","expiration":1}}`,
+		},
+		"xml": {
+			body:     `<Credentials><AccessKeyId>ASIAEXAMPLE</AccessKeyId><SessionToken>token</SessionToken><Expiration>2024-01-01T00:00:00Z</Expiration></Credentials>`,
+			expected: `<Credentials><AccessKeyId>***</AccessKeyId><SessionToken>***</SessionToken><Expiration>2024-01-01T00:00:00Z</Expiration></Credentials>`,
+		},
+		"form": {
+			body:     `Action=AssumeRole&ExternalId=secret-external-id&RoleArn=arn%3Aaws%3Aiam%3A%3A123456789012%3Arole%2FTest&WebIdentityToken=oidc`,
+			expected: `Action=AssumeRole&ExternalId=***&RoleArn=arn%3Aaws%3Aiam%3A%3A123456789012%3Arole%2FTest&WebIdentityToken=***`,
+		},
+		"no secrets": {
+			body:     `{"Accounts":[{"Id":"111111111111"}]}`,
+			expected: `{"Accounts":[{"Id":"111111111111"}]}`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, redactBody([]byte(test.body)))
+		})
+	}
+}
+
+// setLogLevel sets TF_LOG to level, clearing the more specific variables
+// that would take precedence over it
+func setLogLevel(t *testing.T, level string) {
+	for _, envVar := range logLevelEnvVars {
+		t.Setenv(envVar, "")
+	}
+	t.Setenv("TF_LOG", level)
+}
+
+func TestTraceMiddlewareLogsOperations(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		w.Header().Set("X-Amzn-RequestId", fmt.Sprintf("request-%d", requests.Add(1)))
+		if requests.Load() == 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"__type":"TooManyRequestsException","Message":"Rate exceeded"}`)
+			return
+		}
+		fmt.Fprint(w, `{"Accounts":[{"Id":"111111111111","Name":"management","Status":"ACTIVE"}]}`)
+	}))
+	t.Cleanup(server.Close)
+
+	testClient := newRetryTestClient(t, server.URL, ClientConfig{MaxBackoff: time.Millisecond})
+	setLogLevel(t, "TRACE")
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	accounts, err := testClient.GetAccountInfo(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, accounts, 1)
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	assert.NoError(t, err)
+
+	var traces []map[string]interface{}
+	for _, entry := range entries {
+		if entry["@level"] == "trace" {
+			traces = append(traces, entry)
+		}
+	}
+	if !assert.Len(t, traces, 5) {
+		return
+	}
+
+	for _, i := range []int{0, 2} {
+		assert.Equal(t, "Sending AWS request", traces[i]["@message"])
+		assert.Equal(t, "{}", traces[i][logKeyRequestBody])
+	}
+	assert.Equal(t, "Received AWS response", traces[1]["@message"])
+	assert.Equal(t, float64(http.StatusBadRequest), traces[1][logKeyStatusCode])
+	assert.Equal(t, "request-1", traces[1][logKeyRequestID])
+	assert.Contains(t, traces[1][logKeyResponseBody], "TooManyRequestsException")
+
+	assert.Equal(t, "Received AWS response", traces[3]["@message"])
+	assert.Equal(t, float64(http.StatusOK), traces[3][logKeyStatusCode])
+	assert.Equal(t, "request-2", traces[3][logKeyRequestID])
+
+	operation := traces[4]
+	assert.Equal(t, "AWS operation completed", operation["@message"])
+	assert.Equal(t, "provider."+logSubsystem, operation["@module"])
+	assert.Equal(t, "Organizations", operation[logKeyService])
+	assert.Equal(t, "ListAccounts", operation[logKeyOperation])
+	assert.Equal(t, "request-2", operation[logKeyRequestID])
+	assert.Equal(t, float64(1), operation[logKeyRetries])
+	assert.Contains(t, operation, logKeyLatency)
+	assert.NotContains(t, operation, logKeyError)
+}
+
+func TestTraceMiddlewareSkipsBodiesBelowTrace(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		fmt.Fprint(w, `{"Accounts":[{"Id":"111111111111","Name":"management","Status":"ACTIVE"}]}`)
+	}))
+	t.Cleanup(server.Close)
+
+	testClient := newRetryTestClient(t, server.URL, ClientConfig{})
+	setLogLevel(t, "DEBUG")
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	accounts, err := testClient.GetAccountInfo(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, accounts, 1)
+
+	assert.NotContains(t, output.String(), logKeyRequestBody)
+	assert.NotContains(t, output.String(), logKeyResponseBody)
+}
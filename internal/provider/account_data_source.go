@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/eaglespirittech/terraform-provider-controltowermanagement/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ datasource.DataSource                     = &accountDataSource{}
+	_ datasource.DataSourceWithConfigure        = &accountDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &accountDataSource{}
+)
+
+// accountDataSource is the data source implementation.
+type accountDataSource struct {
+	client *client.Client
+}
+
+// accountDataSourceModel describes the data source data model.
+type accountDataSourceModel struct {
+	AccountId       types.String `tfsdk:"account_id"`
+	Name            types.String `tfsdk:"name"`
+	Email           types.String `tfsdk:"email"`
+	Arn             types.String `tfsdk:"arn"`
+	Status          types.String `tfsdk:"status"`
+	JoinedMethod    types.String `tfsdk:"joined_method"`
+	JoinedTimestamp types.String `tfsdk:"joined_timestamp"`
+	ParentId        types.String `tfsdk:"parent_id"`
+	ParentPath      types.String `tfsdk:"parent_path"`
+}
+
+// NewAccountDataSource is a helper function to simplify the provider implementation.
+func NewAccountDataSource() datasource.DataSource {
+	return &accountDataSource{}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *accountDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *accountDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account"
+}
+
+// Schema defines the schema for the data source.
+func (d *accountDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to look up a single AWS account in your organization by ID, name or email address.",
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				Description: "The ID of the AWS account. Exactly one of account_id, name or email must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The exact name of the AWS account. Exactly one of account_id, name or email must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"email": schema.StringAttribute{
+				Description: "The email address of the AWS account, compared case-insensitively. Exactly one of account_id, name or email must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"arn": schema.StringAttribute{
+				Description: "The ARN of the AWS account",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the account (ACTIVE, SUSPENDED, etc.)",
+				Computed:    true,
+			},
+			"joined_method": schema.StringAttribute{
+				Description: "How the account joined the organization (INVITED or CREATED)",
+				Computed:    true,
+			},
+			"joined_timestamp": schema.StringAttribute{
+				Description: "When the account joined the organization, in RFC 3339 format",
+				Computed:    true,
+			},
+			"parent_id": schema.StringAttribute{
+				Description: "The ID of the root or organizational unit that contains the account",
+				Computed:    true,
+			},
+			"parent_path": schema.StringAttribute{
+				Description: "The path of the root or organizational unit that contains the account (e.g., Root/Workloads/Prod)",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ConfigValidators ensures exactly one lookup argument is configured.
+func (d *accountDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("account_id"),
+			path.MatchRoot("name"),
+			path.MatchRoot("email"),
+		),
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *accountDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state accountDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client == nil {
+		resp.Diagnostics.AddError(
+			"Client Not Configured",
+			"Expected configured client but got nil. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	var account *client.AccountInfo
+	var err error
+	var lookup string
+	switch {
+	case !state.AccountId.IsNull():
+		lookup = "ID " + state.AccountId.ValueString()
+		account, err = d.client.DescribeAccount(ctx, state.AccountId.ValueString())
+	case !state.Name.IsNull():
+		lookup = "name " + state.Name.ValueString()
+		account, err = d.client.FindAccount(ctx, &client.AccountFilter{
+			NameRegex: regexp.MustCompile("^" + regexp.QuoteMeta(state.Name.ValueString()) + "$"),
+		})
+	default:
+		lookup = "email " + state.Email.ValueString()
+		account, err = d.client.FindAccount(ctx, &client.AccountFilter{
+			EmailRegex: regexp.MustCompile("(?i)^" + regexp.QuoteMeta(state.Email.ValueString()) + "$"),
+		})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading AWS Account",
+			fmt.Sprintf("Could not find the AWS account with %s: %s", lookup, err.Error()),
+		)
+		return
+	}
+
+	if err := d.client.ResolveAccountParent(ctx, account); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading AWS Account",
+			fmt.Sprintf("Could not resolve the parent organizational unit of AWS account %s: %s", account.AccountId, err.Error()),
+		)
+		return
+	}
+
+	// Only fill in the lookup arguments that were not configured, so that an
+	// email address configured in a different case is kept as is
+	if state.AccountId.IsNull() {
+		state.AccountId = types.StringValue(account.AccountId)
+	}
+	if state.Name.IsNull() {
+		state.Name = types.StringValue(account.AccountName)
+	}
+	if state.Email.IsNull() {
+		state.Email = types.StringValue(account.Email)
+	}
+	state.Arn = types.StringValue(account.Arn)
+	state.Status = types.StringValue(account.Status)
+	state.JoinedMethod = types.StringValue(account.JoinedMethod)
+	state.JoinedTimestamp = timeStringValue(account.JoinedTimestamp)
+	state.ParentId = types.StringValue(account.ParentId)
+	state.ParentPath = types.StringValue(account.ParentPath)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
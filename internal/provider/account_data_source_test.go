@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAccountDataSource(t *testing.T) {
+	testAccPreCheck(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.controltowermanagement_account.by_name", "account_id", "data.controltowermanagement_aws_account.all", "accounts.0.account_id"),
+					resource.TestCheckResourceAttrPair("data.controltowermanagement_account.by_id", "name", "data.controltowermanagement_aws_account.all", "accounts.0.account_name"),
+					resource.TestCheckResourceAttrSet("data.controltowermanagement_account.by_id", "arn"),
+					resource.TestCheckResourceAttrSet("data.controltowermanagement_account.by_id", "parent_id"),
+					resource.TestCheckResourceAttrPair("data.controltowermanagement_account.by_email", "account_id", "data.controltowermanagement_aws_account.all", "accounts.0.account_id"),
+				),
+			},
+			{
+				Config:      testAccAccountDataSourceNoMatchConfig(),
+				ExpectError: regexp.MustCompile("no account matched"),
+			},
+		},
+	})
+}
+
+func testAccAccountDataSourceConfig() string {
+	return testAccProviderConfig() + `
+data "controltowermanagement_aws_account" "all" {}
+
+data "controltowermanagement_account" "by_id" {
+  account_id = data.controltowermanagement_aws_account.all.accounts[0].account_id
+}
+
+data "controltowermanagement_account" "by_name" {
+  name = data.controltowermanagement_aws_account.all.accounts[0].account_name
+}
+
+data "controltowermanagement_account" "by_email" {
+  email = upper(data.controltowermanagement_aws_account.all.accounts[0].email)
+}
+`
+}
+
+func testAccAccountDataSourceNoMatchConfig() string {
+	return testAccProviderConfig() + `
+data "controltowermanagement_account" "missing" {
+  name = "tf-acc-test-account-that-does-not-exist"
+}
+`
+}
@@ -0,0 +1,392 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/eaglespirittech/terraform-provider-controltowermanagement/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ resource.Resource                = &accountResource{}
+	_ resource.ResourceWithConfigure   = &accountResource{}
+	_ resource.ResourceWithImportState = &accountResource{}
+	_ resource.ResourceWithModifyPlan  = &accountResource{}
+)
+
+// accountResource is the resource implementation.
+type accountResource struct {
+	client *client.Client
+}
+
+// accountResourceModel describes the resource data model.
+type accountResourceModel struct {
+	Id                        types.String `tfsdk:"id"`
+	ProvisionedProductName    types.String `tfsdk:"provisioned_product_name"`
+	AccountName               types.String `tfsdk:"account_name"`
+	AccountEmail              types.String `tfsdk:"account_email"`
+	SSOUserEmail              types.String `tfsdk:"sso_user_email"`
+	SSOUserFirstName          types.String `tfsdk:"sso_user_first_name"`
+	SSOUserLastName           types.String `tfsdk:"sso_user_last_name"`
+	ManagedOrganizationalUnit types.String `tfsdk:"managed_organizational_unit"`
+	CloseAccountOnDelete      types.Bool   `tfsdk:"close_account_on_delete"`
+	AccountId                 types.String `tfsdk:"account_id"`
+	Status                    types.String `tfsdk:"status"`
+}
+
+// NewAccountResource is a helper function to simplify the provider implementation.
+func NewAccountResource() resource.Resource {
+	return &accountResource{}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *accountResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *accountResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account"
+}
+
+// Schema defines the schema for the resource.
+func (r *accountResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Vends an AWS account through AWS Control Tower Account Factory.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the Account Factory provisioned product",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"provisioned_product_name": schema.StringAttribute{
+				Description: "The name of the Account Factory provisioned product. Defaults to account_name.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"account_name": schema.StringAttribute{
+				Description: "The name of the account. Changing it renames the account in place.",
+				Required:    true,
+			},
+			"account_email": schema.StringAttribute{
+				Description: "The root email address of the account. It cannot be changed once the account is created.",
+				Required:    true,
+			},
+			"sso_user_email": schema.StringAttribute{
+				Description: "The email address of the IAM Identity Center user given access to the account",
+				Required:    true,
+			},
+			"sso_user_first_name": schema.StringAttribute{
+				Description: "The first name of the IAM Identity Center user",
+				Required:    true,
+			},
+			"sso_user_last_name": schema.StringAttribute{
+				Description: "The last name of the IAM Identity Center user",
+				Required:    true,
+			},
+			"managed_organizational_unit": schema.StringAttribute{
+				Description: "The registered organizational unit for the account, as \"Name (ou-id)\". Changing it moves the account in place.",
+				Required:    true,
+			},
+			"close_account_on_delete": schema.BoolAttribute{
+				Description: "Whether to close the account when the resource is destroyed. When false, only the provisioned product is terminated and the account remains in the organization.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"account_id": schema.StringAttribute{
+				Description: "The ID of the vended AWS account",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the provisioned product (AVAILABLE, UNDER_CHANGE, TAINTED, ERROR)",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ModifyPlan rejects changes that Account Factory cannot apply in place.
+func (r *accountResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan accountResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.AccountEmail.IsUnknown() && !plan.AccountEmail.Equal(state.AccountEmail) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("account_email"),
+			"Account Email Cannot Be Changed",
+			fmt.Sprintf("Account Factory cannot change the root email address of account %s from %s to %s. Change it from the account's root user settings and update the configuration to match.",
+				state.AccountId.ValueString(), state.AccountEmail.ValueString(), plan.AccountEmail.ValueString()),
+		)
+	}
+}
+
+// Create vends the account and waits for provisioning to complete.
+func (r *accountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan accountResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ProvisionedProductName.IsUnknown() || plan.ProvisionedProductName.IsNull() {
+		plan.ProvisionedProductName = plan.AccountName
+	}
+
+	provisionedProductID, recordID, err := r.client.ProvisionAccount(ctx, plan.ProvisionedProductName.ValueString(), accountFactoryInput(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Account",
+			fmt.Sprintf("Could not provision account %s: %s", plan.AccountName.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// Persist the provisioned product so a failed provisioning leaves a tainted resource rather than an orphan
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), provisionedProductID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("close_account_on_delete"), plan.CloseAccountOnDelete)...)
+
+	if err := r.client.WaitForProvisioningRecord(ctx, recordID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Account",
+			fmt.Sprintf("Account %s did not finish provisioning: %s", plan.AccountName.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	plan.Id = types.StringValue(provisionedProductID)
+	resp.Diagnostics.Append(r.refresh(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *accountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state accountResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	info, err := r.client.GetProvisionedAccount(ctx, state.Id.ValueString())
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Account",
+			fmt.Sprintf("Could not read provisioned account %s: %s", state.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.setState(ctx, &state, info)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state.CloseAccountOnDelete.IsNull() {
+		state.CloseAccountOnDelete = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update renames or moves the account, or changes its IAM Identity Center user.
+func (r *accountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state accountResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = state.Id
+
+	// Only close_account_on_delete changed; nothing to send to Account Factory
+	if plan.AccountName.Equal(state.AccountName) &&
+		plan.SSOUserEmail.Equal(state.SSOUserEmail) &&
+		plan.SSOUserFirstName.Equal(state.SSOUserFirstName) &&
+		plan.SSOUserLastName.Equal(state.SSOUserLastName) &&
+		plan.ManagedOrganizationalUnit.Equal(state.ManagedOrganizationalUnit) {
+		plan.AccountId = state.AccountId
+		plan.Status = state.Status
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	recordID, err := r.client.UpdateProvisionedAccount(ctx, state.Id.ValueString(), accountFactoryInput(&plan))
+	if err == nil {
+		err = r.client.WaitForProvisioningRecord(ctx, recordID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Account",
+			fmt.Sprintf("Could not update provisioned account %s: %s", state.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.refresh(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete terminates the provisioned product and, when requested, closes the account.
+func (r *accountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state accountResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recordID, err := r.client.TerminateProvisionedAccount(ctx, state.Id.ValueString())
+	if err == nil {
+		err = r.client.WaitForProvisioningRecord(ctx, recordID)
+	}
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Account",
+			fmt.Sprintf("Could not terminate provisioned account %s: %s", state.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	if !state.CloseAccountOnDelete.ValueBool() {
+		return
+	}
+
+	accountID := state.AccountId.ValueString()
+	if accountID == "" {
+		resp.Diagnostics.AddWarning(
+			"Account Not Closed",
+			fmt.Sprintf("The provisioned product %s was terminated but its account ID is unknown, so the account was not closed.", state.Id.ValueString()),
+		)
+		return
+	}
+
+	if err := r.client.CloseAccount(ctx, accountID); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Closing Account",
+			fmt.Sprintf("The provisioned product %s was terminated but account %s could not be closed: %s", state.Id.ValueString(), accountID, err.Error()),
+		)
+	}
+}
+
+// ImportState imports an account by its provisioned product ID.
+func (r *accountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("close_account_on_delete"), false)...)
+}
+
+// refresh reads the provisioned account back into the model after a create or update.
+func (r *accountResource) refresh(ctx context.Context, model *accountResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	info, err := r.client.GetProvisionedAccount(ctx, model.Id.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error Reading Account",
+			fmt.Sprintf("Could not read provisioned account %s: %s", model.Id.ValueString(), err.Error()),
+		)
+		return diags
+	}
+
+	diags.Append(r.setState(ctx, model, info)...)
+	return diags
+}
+
+// setState maps the provisioned product and the account it vended onto the model.
+func (r *accountResource) setState(ctx context.Context, model *accountResourceModel, info *client.ProvisionedAccountInfo) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.Id = types.StringValue(info.ProvisionedProductId)
+	model.ProvisionedProductName = types.StringValue(info.ProvisionedProductName)
+	model.AccountId = types.StringValue(info.AccountId)
+	model.Status = types.StringValue(info.Status)
+	if info.AccountEmail != "" {
+		model.AccountEmail = types.StringValue(info.AccountEmail)
+	}
+	// The IAM Identity Center user and organizational unit are only known
+	// from the provisioned product's parameters, which also covers imports
+	if info.Parameters != nil {
+		model.SSOUserEmail = types.StringValue(info.Parameters.SSOUserEmail)
+		model.SSOUserFirstName = types.StringValue(info.Parameters.SSOUserFirstName)
+		model.SSOUserLastName = types.StringValue(info.Parameters.SSOUserLastName)
+		model.ManagedOrganizationalUnit = types.StringValue(info.Parameters.ManagedOrganizationalUnit)
+	}
+
+	if info.AccountId == "" {
+		return diags
+	}
+
+	account, err := r.client.DescribeAccount(ctx, info.AccountId)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Account",
+			fmt.Sprintf("Could not describe account %s: %s", info.AccountId, err.Error()),
+		)
+		return diags
+	}
+
+	model.AccountName = types.StringValue(account.AccountName)
+	model.AccountEmail = types.StringValue(account.Email)
+	return diags
+}
+
+// accountFactoryInput builds the Account Factory parameters from the model.
+func accountFactoryInput(model *accountResourceModel) *client.AccountFactoryInput {
+	return &client.AccountFactoryInput{
+		AccountName:               model.AccountName.ValueString(),
+		AccountEmail:              model.AccountEmail.ValueString(),
+		SSOUserEmail:              model.SSOUserEmail.ValueString(),
+		SSOUserFirstName:          model.SSOUserFirstName.ValueString(),
+		SSOUserLastName:           model.SSOUserLastName.ValueString(),
+		ManagedOrganizationalUnit: model.ManagedOrganizationalUnit.ValueString(),
+	}
+}
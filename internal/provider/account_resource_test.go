@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAccountResource(t *testing.T) {
+	testAccPreCheck(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckEnv(t, "CONTROLTOWER_ACCOUNT_EMAIL", "CONTROLTOWER_SSO_USER_EMAIL", "CONTROLTOWER_MANAGED_OU")
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountResourceConfig("tf-acc-test-account"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("controltowermanagement_account.test", "account_id"),
+					resource.TestCheckResourceAttr("controltowermanagement_account.test", "account_name", "tf-acc-test-account"),
+					resource.TestCheckResourceAttr("controltowermanagement_account.test", "status", "AVAILABLE"),
+				),
+			},
+			{
+				Config: testAccAccountResourceConfig("tf-acc-test-account-renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("controltowermanagement_account.test", "account_name", "tf-acc-test-account-renamed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccountResourceConfig(accountName string) string {
+	return testAccProviderConfig() + `
+resource "controltowermanagement_account" "test" {
+  provisioned_product_name    = "tf-acc-test-account"
+  account_name                = "` + accountName + `"
+  account_email               = "` + os.Getenv("CONTROLTOWER_ACCOUNT_EMAIL") + `"
+  sso_user_email              = "` + os.Getenv("CONTROLTOWER_SSO_USER_EMAIL") + `"
+  sso_user_first_name         = "Terraform"
+  sso_user_last_name          = "Acceptance"
+  managed_organizational_unit = "` + os.Getenv("CONTROLTOWER_MANAGED_OU") + `"
+  close_account_on_delete     = true
+}
+`
+}
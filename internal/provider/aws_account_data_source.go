@@ -3,10 +3,16 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
 
 	"github.com/eaglespirittech/terraform-provider-controltowermanagement/internal/client"
+	"github.com/eaglespirittech/terraform-provider-controltowermanagement/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -23,15 +29,26 @@ type awsAccountDataSource struct {
 
 // awsAccountDataSourceModel describes the data source data model.
 type awsAccountDataSourceModel struct {
-	Accounts []awsAccountModel `tfsdk:"accounts"`
+	Status     types.String      `tfsdk:"status"`
+	NameRegex  types.String      `tfsdk:"name_regex"`
+	EmailRegex types.String      `tfsdk:"email_regex"`
+	ParentId   types.String      `tfsdk:"parent_id"`
+	Recursive  types.Bool        `tfsdk:"recursive"`
+	Tags       types.Map         `tfsdk:"tags"`
+	Accounts   []awsAccountModel `tfsdk:"accounts"`
 }
 
 // awsAccountModel describes the AWS account model.
 type awsAccountModel struct {
-	AccountId   types.String `tfsdk:"account_id"`
-	AccountName types.String `tfsdk:"account_name"`
-	Email       types.String `tfsdk:"email"`
-	Status      types.String `tfsdk:"status"`
+	AccountId       types.String `tfsdk:"account_id"`
+	AccountName     types.String `tfsdk:"account_name"`
+	Email           types.String `tfsdk:"email"`
+	Status          types.String `tfsdk:"status"`
+	Arn             types.String `tfsdk:"arn"`
+	JoinedMethod    types.String `tfsdk:"joined_method"`
+	JoinedTimestamp types.String `tfsdk:"joined_timestamp"`
+	ParentId        types.String `tfsdk:"parent_id"`
+	ParentPath      types.String `tfsdk:"parent_path"`
 }
 
 // NewAwsAccountDataSource is a helper function to simplify the provider implementation.
@@ -65,8 +82,42 @@ func (d *awsAccountDataSource) Metadata(_ context.Context, req datasource.Metada
 // Schema defines the schema for the data source.
 func (d *awsAccountDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Use this data source to get information about AWS accounts in your organization.",
+		Description: "Use this data source to get information about AWS accounts in your organization, optionally filtered.",
 		Attributes: map[string]schema.Attribute{
+			"status": schema.StringAttribute{
+				Description: "Only return accounts with this status (ACTIVE, SUSPENDED or PENDING_CLOSURE)",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("ACTIVE", "SUSPENDED", "PENDING_CLOSURE"),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only return accounts whose name matches this regular expression",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.ValidRegex(),
+				},
+			},
+			"email_regex": schema.StringAttribute{
+				Description: "Only return accounts whose email address matches this regular expression",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.ValidRegex(),
+				},
+			},
+			"parent_id": schema.StringAttribute{
+				Description: "Only return accounts directly under this root or organizational unit",
+				Optional:    true,
+			},
+			"recursive": schema.BoolAttribute{
+				Description: "Also return accounts in organizational units nested below parent_id (default false)",
+				Optional:    true,
+			},
+			"tags": schema.MapAttribute{
+				Description: "Only return accounts that have all of these tags with these values",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
 			"accounts": schema.ListNestedAttribute{
 				Description: "List of AWS accounts in the organization",
 				Computed:    true,
@@ -88,6 +139,26 @@ func (d *awsAccountDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 							Description: "The status of the account (ACTIVE, SUSPENDED, etc.)",
 							Computed:    true,
 						},
+						"arn": schema.StringAttribute{
+							Description: "The ARN of the AWS account",
+							Computed:    true,
+						},
+						"joined_method": schema.StringAttribute{
+							Description: "How the account joined the organization (INVITED or CREATED)",
+							Computed:    true,
+						},
+						"joined_timestamp": schema.StringAttribute{
+							Description: "When the account joined the organization, in RFC 3339 format",
+							Computed:    true,
+						},
+						"parent_id": schema.StringAttribute{
+							Description: "The ID of the root or organizational unit that contains the account, or null when it cannot be resolved",
+							Computed:    true,
+						},
+						"parent_path": schema.StringAttribute{
+							Description: "The path of the root or organizational unit that contains the account (e.g., Root/Workloads/Prod), or null when it cannot be resolved",
+							Computed:    true,
+						},
 					},
 				},
 			},
@@ -112,7 +183,13 @@ func (d *awsAccountDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	accounts, err := d.client.GetAccountInfo(ctx)
+	filter, diags := newAccountFilter(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accounts, err := d.client.FindAccounts(ctx, filter)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading AWS Accounts",
@@ -121,13 +198,27 @@ func (d *awsAccountDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
+	// The parents are informational, so a failure to resolve them leaves them
+	// null instead of failing the whole read
+	if err := d.client.ResolveAccountParents(ctx, accounts); err != nil {
+		resp.Diagnostics.AddWarning(
+			"Could Not Resolve Account Parents",
+			fmt.Sprintf("The parent_id and parent_path of some accounts are left null: %s\n\nCheck that the credentials have the organizations:ListParents, organizations:DescribeOrganizationalUnit and organizations:ListRoots permissions.", err.Error()),
+		)
+	}
+
 	// Map response body to model
 	for _, account := range accounts {
 		accountState := awsAccountModel{
-			AccountId:   types.StringValue(account.AccountId),
-			AccountName: types.StringValue(account.AccountName),
-			Email:       types.StringValue(account.Email),
-			Status:      types.StringValue(account.Status),
+			AccountId:       types.StringValue(account.AccountId),
+			AccountName:     types.StringValue(account.AccountName),
+			Email:           types.StringValue(account.Email),
+			Status:          types.StringValue(account.Status),
+			Arn:             types.StringValue(account.Arn),
+			JoinedMethod:    types.StringValue(account.JoinedMethod),
+			JoinedTimestamp: timeStringValue(account.JoinedTimestamp),
+			ParentId:        optionalStringValue(account.ParentId),
+			ParentPath:      optionalStringValue(account.ParentPath),
 		}
 		state.Accounts = append(state.Accounts, accountState)
 	}
@@ -139,3 +230,41 @@ func (d *awsAccountDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 }
+
+// newAccountFilter builds the client account filter from the data source configuration.
+func newAccountFilter(ctx context.Context, config *awsAccountDataSourceModel) (*client.AccountFilter, diag.Diagnostics) {
+	filter := &client.AccountFilter{
+		Status:    config.Status.ValueString(),
+		ParentId:  config.ParentId.ValueString(),
+		Recursive: config.Recursive.ValueBool(),
+	}
+
+	var diags diag.Diagnostics
+	filter.NameRegex = compileRegexValue(path.Root("name_regex"), config.NameRegex, &diags)
+	filter.EmailRegex = compileRegexValue(path.Root("email_regex"), config.EmailRegex, &diags)
+
+	tags, tagDiags := stringMapValue(ctx, config.Tags)
+	diags.Append(tagDiags...)
+	filter.Tags = tags
+
+	return filter, diags
+}
+
+// compileRegexValue compiles a regular expression argument, returning nil
+// when it is null and adding an attribute error when it does not compile
+func compileRegexValue(attributePath path.Path, value types.String, diags *diag.Diagnostics) *regexp.Regexp {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	pattern, err := regexp.Compile(value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid Regular Expression",
+			fmt.Sprintf("Could not compile %q: %s", value.ValueString(), err.Error()),
+		)
+		return nil
+	}
+	return pattern
+}
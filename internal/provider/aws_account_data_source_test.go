@@ -1,12 +1,17 @@
 package provider
 
 import (
+	"context"
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -39,6 +44,17 @@ func TestAccAwsAccountDataSource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.controltowermanagement_aws_account.test", "accounts.0.account_name"),
 					resource.TestCheckResourceAttrSet("data.controltowermanagement_aws_account.test", "accounts.0.email"),
 					resource.TestCheckResourceAttrSet("data.controltowermanagement_aws_account.test", "accounts.0.status"),
+					resource.TestCheckResourceAttrSet("data.controltowermanagement_aws_account.test", "accounts.0.arn"),
+					resource.TestCheckResourceAttrSet("data.controltowermanagement_aws_account.test", "accounts.0.joined_method"),
+					resource.TestCheckResourceAttrSet("data.controltowermanagement_aws_account.test", "accounts.0.joined_timestamp"),
+					resource.TestCheckResourceAttrSet("data.controltowermanagement_aws_account.test", "accounts.0.parent_id"),
+					resource.TestCheckResourceAttrSet("data.controltowermanagement_aws_account.test", "accounts.0.parent_path"),
+				),
+			},
+			{
+				Config: testAccAwsAccountDataSourceFilteredConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.controltowermanagement_aws_account.test", "accounts.0.status", "ACTIVE"),
 				),
 			},
 		},
@@ -56,3 +72,25 @@ provider "controltowermanagement" {
 data "controltowermanagement_aws_account" "test" {}
 `
 }
+
+func testAccAwsAccountDataSourceFilteredConfig() string {
+	return testAccProviderConfig() + `
+data "controltowermanagement_aws_account" "test" {
+  status     = "ACTIVE"
+  name_regex = ".+"
+}
+`
+}
+
+func TestNewAccountFilterInvalidRegex(t *testing.T) {
+	filter, diags := newAccountFilter(context.Background(), &awsAccountDataSourceModel{
+		NameRegex:  types.StringValue("^workload-"),
+		EmailRegex: types.StringValue("(unclosed"),
+		Tags:       types.MapNull(types.StringType),
+	})
+
+	assert.True(t, diags.HasError())
+	assert.Equal(t, path.Root("email_regex"), diags.Errors()[0].(diag.DiagnosticWithPath).Path())
+	assert.Equal(t, "^workload-", filter.NameRegex.String())
+	assert.Nil(t, filter.EmailRegex)
+}
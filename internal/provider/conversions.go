@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// stringMapValue converts a Terraform map of strings into a Go map, returning
+// nil when the map is null or unknown
+func stringMapValue(ctx context.Context, value types.Map) (map[string]string, diag.Diagnostics) {
+	if value.IsNull() || value.IsUnknown() {
+		return nil, nil
+	}
+
+	var result map[string]string
+	diags := value.ElementsAs(ctx, &result, false)
+	return result, diags
+}
+
+// stringListValue converts a Terraform list of strings into a Go slice,
+// returning nil when the list is null or unknown
+func stringListValue(ctx context.Context, value types.List) ([]string, diag.Diagnostics) {
+	if value.IsNull() || value.IsUnknown() {
+		return nil, nil
+	}
+
+	var result []string
+	diags := value.ElementsAs(ctx, &result, false)
+	return result, diags
+}
+
+// stringSetValue converts a Terraform set of strings into a Go slice,
+// returning nil when the set is null or unknown
+func stringSetValue(ctx context.Context, value types.Set) ([]string, diag.Diagnostics) {
+	if value.IsNull() || value.IsUnknown() {
+		return nil, nil
+	}
+
+	var result []string
+	diags := value.ElementsAs(ctx, &result, false)
+	return result, diags
+}
+
+// optionalStringValue returns null for an empty string
+func optionalStringValue(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// timeStringValue formats a time in RFC 3339 format, returning null for the
+// zero time
+func timeStringValue(value time.Time) types.String {
+	if value.IsZero() {
+		return types.StringNull()
+	}
+	return types.StringValue(value.Format(time.RFC3339))
+}
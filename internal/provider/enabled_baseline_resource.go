@@ -0,0 +1,359 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/eaglespirittech/terraform-provider-controltowermanagement/internal/client"
+	"github.com/eaglespirittech/terraform-provider-controltowermanagement/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ resource.Resource                = &enabledBaselineResource{}
+	_ resource.ResourceWithConfigure   = &enabledBaselineResource{}
+	_ resource.ResourceWithImportState = &enabledBaselineResource{}
+	_ resource.ResourceWithModifyPlan  = &enabledBaselineResource{}
+)
+
+// enabledBaselineResource is the resource implementation.
+type enabledBaselineResource struct {
+	client *client.Client
+}
+
+// enabledBaselineResourceModel describes the resource data model.
+type enabledBaselineResourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	Arn                types.String `tfsdk:"arn"`
+	BaselineIdentifier types.String `tfsdk:"baseline_identifier"`
+	BaselineVersion    types.String `tfsdk:"baseline_version"`
+	TargetIdentifier   types.String `tfsdk:"target_identifier"`
+	Parameters         types.Map    `tfsdk:"parameters"`
+	ResetOnFailure     types.Bool   `tfsdk:"reset_on_failure"`
+	Status             types.String `tfsdk:"status"`
+}
+
+// NewEnabledBaselineResource is a helper function to simplify the provider implementation.
+func NewEnabledBaselineResource() resource.Resource {
+	return &enabledBaselineResource{}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *enabledBaselineResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *enabledBaselineResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_enabled_baseline"
+}
+
+// Schema defines the schema for the resource.
+func (r *enabledBaselineResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Enables an AWS Control Tower baseline (such as AWSControlTowerBaseline, IdentityCenterBaseline or BackupBaseline) on an organizational unit or account.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ARN of the enabled baseline",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"arn": schema.StringAttribute{
+				Description: "The ARN of the enabled baseline",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"baseline_identifier": schema.StringAttribute{
+				Description: "The ARN of the baseline to enable",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"baseline_version": schema.StringAttribute{
+				Description: "The version of the baseline to enable (e.g., 4.0)",
+				Required:    true,
+			},
+			"target_identifier": schema.StringAttribute{
+				Description: "The ARN of the organizational unit or account on which the baseline is enabled",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parameters": schema.MapAttribute{
+				Description: "Map of baseline parameter names to JSON-encoded values (e.g., IdentityCenterEnabledBaselineArn = jsonencode(\"arn:...\"))",
+				ElementType: jsonStringType{},
+				Optional:    true,
+				Validators: []validator.Map{
+					mapvalidator.ValueStringsAre(validators.JSONDocument()),
+				},
+			},
+			"reset_on_failure": schema.BoolAttribute{
+				Description: "Whether to reset the enabled baseline when its enablement status is FAILED",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"status": schema.StringAttribute{
+				Description: "The enablement status of the baseline (SUCCEEDED, FAILED, UNDER_CHANGE)",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ModifyPlan plans a reset when the enabled baseline has failed and reset_on_failure is enabled.
+func (r *enabledBaselineResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan enabledBaselineResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The reset can end in any status, so plan it as unknown, which also
+	// makes the reset show up as an update
+	if plan.ResetOnFailure.ValueBool() && state.Status.ValueString() == "FAILED" {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), types.StringUnknown())...)
+	}
+}
+
+// Create enables the baseline and waits for the operation to complete.
+func (r *enabledBaselineResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan enabledBaselineResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	baselineIdentifier := plan.BaselineIdentifier.ValueString()
+	targetIdentifier := plan.TargetIdentifier.ValueString()
+
+	parameters, diags := stringMapValue(ctx, plan.Parameters)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	arn, operationID, err := r.client.EnableBaseline(ctx, baselineIdentifier, plan.BaselineVersion.ValueString(), targetIdentifier, parameters)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Enabling Baseline",
+			fmt.Sprintf("Could not enable baseline %s on %s: %s", baselineIdentifier, targetIdentifier, err.Error()),
+		)
+		return
+	}
+
+	// Persist the ARN so a failed operation leaves a tainted resource rather than an orphan
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), arn)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("arn"), arn)...)
+
+	if err := r.client.WaitForBaselineOperation(ctx, operationID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Enabling Baseline",
+			fmt.Sprintf("Baseline %s did not finish enabling on %s: %s", baselineIdentifier, targetIdentifier, err.Error()),
+		)
+		return
+	}
+
+	info, err := r.client.GetEnabledBaseline(ctx, arn)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Enabled Baseline",
+			fmt.Sprintf("Could not read enabled baseline %s: %s", arn, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setEnabledBaselineState(ctx, &plan, info)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *enabledBaselineResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state enabledBaselineResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var info *client.EnabledBaselineInfo
+	var err error
+	if state.Arn.IsNull() || state.Arn.ValueString() == "" {
+		info, err = r.client.FindEnabledBaseline(ctx, state.BaselineIdentifier.ValueString(), state.TargetIdentifier.ValueString())
+	} else {
+		info, err = r.client.GetEnabledBaseline(ctx, state.Arn.ValueString())
+	}
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Enabled Baseline",
+			fmt.Sprintf("Could not read enabled baseline %s: %s", state.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setEnabledBaselineState(ctx, &state, info)...)
+	if state.ResetOnFailure.IsNull() {
+		state.ResetOnFailure = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the baseline version and parameters, or resets a failed baseline.
+func (r *enabledBaselineResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state enabledBaselineResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	arn := state.Arn.ValueString()
+
+	if !plan.BaselineVersion.Equal(state.BaselineVersion) || !plan.Parameters.Equal(state.Parameters) {
+		parameters, diags := stringMapValue(ctx, plan.Parameters)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if parameters == nil {
+			parameters = map[string]string{}
+		}
+
+		operationID, err := r.client.UpdateEnabledBaseline(ctx, arn, plan.BaselineVersion.ValueString(), parameters)
+		if err == nil {
+			err = r.client.WaitForBaselineOperation(ctx, operationID)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Enabled Baseline",
+				fmt.Sprintf("Could not update enabled baseline %s: %s", arn, err.Error()),
+			)
+			return
+		}
+	} else if plan.ResetOnFailure.ValueBool() && state.Status.ValueString() == "FAILED" {
+		operationID, err := r.client.ResetEnabledBaseline(ctx, arn)
+		if err == nil {
+			err = r.client.WaitForBaselineOperation(ctx, operationID)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Resetting Enabled Baseline",
+				fmt.Sprintf("Could not reset enabled baseline %s: %s", arn, err.Error()),
+			)
+			return
+		}
+	}
+
+	info, err := r.client.GetEnabledBaseline(ctx, arn)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Enabled Baseline",
+			fmt.Sprintf("Could not read enabled baseline %s: %s", arn, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setEnabledBaselineState(ctx, &plan, info)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete disables the baseline and waits for the operation to complete.
+func (r *enabledBaselineResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state enabledBaselineResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	arn := state.Arn.ValueString()
+
+	operationID, err := r.client.DisableBaseline(ctx, arn)
+	if client.IsNotFound(err) {
+		return
+	}
+	if err == nil {
+		err = r.client.WaitForBaselineOperation(ctx, operationID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Disabling Baseline",
+			fmt.Sprintf("Could not disable enabled baseline %s: %s", arn, err.Error()),
+		)
+	}
+}
+
+// ImportState imports an enabled baseline by its ARN or by "<baseline_arn>,<target_arn>".
+func (r *enabledBaselineResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("reset_on_failure"), false)...)
+
+	if !strings.Contains(req.ID, ",") {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("arn"), req.ID)...)
+		return
+	}
+
+	parts := strings.Split(req.ID, ",")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import Identifier",
+			fmt.Sprintf("Expected an enabled baseline ARN or an import identifier with format <baseline_arn>,<target_arn>, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("baseline_identifier"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("target_identifier"), parts[1])...)
+}
+
+// setEnabledBaselineState maps the enabled baseline details onto the model.
+func setEnabledBaselineState(ctx context.Context, model *enabledBaselineResourceModel, info *client.EnabledBaselineInfo) diag.Diagnostics {
+	model.Id = types.StringValue(info.Arn)
+	model.Arn = types.StringValue(info.Arn)
+	model.BaselineIdentifier = types.StringValue(info.BaselineIdentifier)
+	model.BaselineVersion = types.StringValue(info.BaselineVersion)
+	model.TargetIdentifier = types.StringValue(info.TargetIdentifier)
+	parameters, diags := jsonMapFromStrings(ctx, model.Parameters, info.Parameters)
+	model.Parameters = parameters
+	model.Status = types.StringValue(info.Status)
+
+	return diags
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEnabledBaselineResource(t *testing.T) {
+	testAccPreCheck(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckEnv(t, "CONTROLTOWER_TARGET_OU_ARN", "CONTROLTOWER_BASELINE_ARN", "CONTROLTOWER_IDENTITY_CENTER_ENABLED_BASELINE_ARN")
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnabledBaselineResourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("controltowermanagement_enabled_baseline.test", "arn"),
+					resource.TestCheckResourceAttr("controltowermanagement_enabled_baseline.test", "baseline_version", "4.0"),
+					resource.TestCheckResourceAttr("controltowermanagement_enabled_baseline.test", "status", "SUCCEEDED"),
+				),
+			},
+			{
+				ResourceName:      "controltowermanagement_enabled_baseline.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccEnabledBaselineResourceConfig() string {
+	return testAccProviderConfig() + `
+resource "controltowermanagement_enabled_baseline" "test" {
+  baseline_identifier = "` + os.Getenv("CONTROLTOWER_BASELINE_ARN") + `"
+  baseline_version    = "4.0"
+  target_identifier   = "` + os.Getenv("CONTROLTOWER_TARGET_OU_ARN") + `"
+
+  parameters = {
+    IdentityCenterEnabledBaselineArn = jsonencode("` + os.Getenv("CONTROLTOWER_IDENTITY_CENTER_ENABLED_BASELINE_ARN") + `")
+  }
+}
+`
+}
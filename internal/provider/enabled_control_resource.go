@@ -0,0 +1,322 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/eaglespirittech/terraform-provider-controltowermanagement/internal/client"
+	"github.com/eaglespirittech/terraform-provider-controltowermanagement/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ resource.Resource                = &enabledControlResource{}
+	_ resource.ResourceWithConfigure   = &enabledControlResource{}
+	_ resource.ResourceWithImportState = &enabledControlResource{}
+)
+
+// enabledControlResource is the resource implementation.
+type enabledControlResource struct {
+	client *client.Client
+}
+
+// enabledControlResourceModel describes the resource data model.
+type enabledControlResourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	Arn               types.String `tfsdk:"arn"`
+	ControlIdentifier types.String `tfsdk:"control_identifier"`
+	TargetIdentifier  types.String `tfsdk:"target_identifier"`
+	Parameters        types.Map    `tfsdk:"parameters"`
+	Status            types.String `tfsdk:"status"`
+	DriftStatus       types.String `tfsdk:"drift_status"`
+}
+
+// NewEnabledControlResource is a helper function to simplify the provider implementation.
+func NewEnabledControlResource() resource.Resource {
+	return &enabledControlResource{}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *enabledControlResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *enabledControlResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_enabled_control"
+}
+
+// Schema defines the schema for the resource.
+func (r *enabledControlResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Enables an AWS Control Tower control (guardrail) on an organizational unit.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The control ARN and target ARN separated by a comma",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"arn": schema.StringAttribute{
+				Description: "The ARN of the enabled control",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"control_identifier": schema.StringAttribute{
+				Description: "The ARN of the control to enable",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_identifier": schema.StringAttribute{
+				Description: "The ARN of the organizational unit on which the control is enabled",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parameters": schema.MapAttribute{
+				Description: "Map of control parameter names to JSON-encoded values (e.g., jsonencode([\"us-east-1\"])). Changing parameters updates the control in place.",
+				ElementType: jsonStringType{},
+				Optional:    true,
+				Validators: []validator.Map{
+					mapvalidator.ValueStringsAre(validators.JSONDocument()),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "The enablement status of the control (SUCCEEDED, FAILED, UNDER_CHANGE)",
+				Computed:    true,
+			},
+			"drift_status": schema.StringAttribute{
+				Description: "The drift status of the control (DRIFTED, IN_SYNC, NOT_CHECKING, UNKNOWN)",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Create enables the control and waits for the operation to complete.
+func (r *enabledControlResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan enabledControlResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	controlIdentifier := plan.ControlIdentifier.ValueString()
+	targetIdentifier := plan.TargetIdentifier.ValueString()
+
+	parameters, diags := stringMapValue(ctx, plan.Parameters)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	arn, operationID, err := r.client.EnableControl(ctx, controlIdentifier, targetIdentifier, parameters)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Enabling Control",
+			fmt.Sprintf("Could not enable control %s on %s: %s", controlIdentifier, targetIdentifier, err.Error()),
+		)
+		return
+	}
+
+	// Persist the identifiers so a failed operation leaves a tainted resource
+	// rather than an orphan, and so the tainted resource can be disabled
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), controlIdentifier+","+targetIdentifier)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("arn"), arn)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("control_identifier"), controlIdentifier)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("target_identifier"), targetIdentifier)...)
+
+	if err := r.client.WaitForControlOperation(ctx, operationID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Enabling Control",
+			fmt.Sprintf("Control %s did not finish enabling on %s: %s", controlIdentifier, targetIdentifier, err.Error()),
+		)
+		return
+	}
+
+	info, err := r.client.GetEnabledControl(ctx, arn)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Enabled Control",
+			fmt.Sprintf("Could not read enabled control %s: %s", arn, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setEnabledControlState(ctx, &plan, info)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *enabledControlResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state enabledControlResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var info *client.EnabledControlInfo
+	var err error
+	imported := state.Arn.IsNull() || state.Arn.ValueString() == ""
+	if imported {
+		info, err = r.client.FindEnabledControl(ctx, state.ControlIdentifier.ValueString(), state.TargetIdentifier.ValueString())
+	} else {
+		info, err = r.client.GetEnabledControl(ctx, state.Arn.ValueString())
+	}
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Enabled Control",
+			fmt.Sprintf("Could not read enabled control %s: %s", state.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// An import has no configuration yet, so it takes the identifiers from AWS
+	if imported {
+		state.ControlIdentifier = types.StringNull()
+		state.TargetIdentifier = types.StringNull()
+	}
+
+	resp.Diagnostics.Append(setEnabledControlState(ctx, &state, info)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update replaces the control parameters in place and waits for the operation to complete.
+func (r *enabledControlResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state enabledControlResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parameters, diags := stringMapValue(ctx, plan.Parameters)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if parameters == nil {
+		// Removing every parameter resets the control to its defaults
+		parameters = map[string]string{}
+	}
+
+	arn := state.Arn.ValueString()
+	operationID, err := r.client.UpdateEnabledControl(ctx, arn, parameters)
+	if err == nil {
+		err = r.client.WaitForControlOperation(ctx, operationID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Enabled Control",
+			fmt.Sprintf("Could not update parameters of enabled control %s: %s", arn, err.Error()),
+		)
+		return
+	}
+
+	info, err := r.client.GetEnabledControl(ctx, arn)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Enabled Control",
+			fmt.Sprintf("Could not read enabled control %s: %s", arn, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setEnabledControlState(ctx, &plan, info)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete disables the control and waits for the operation to complete.
+func (r *enabledControlResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state enabledControlResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	controlIdentifier := state.ControlIdentifier.ValueString()
+	targetIdentifier := state.TargetIdentifier.ValueString()
+
+	operationID, err := r.client.DisableControl(ctx, controlIdentifier, targetIdentifier)
+	if client.IsNotFound(err) {
+		return
+	}
+	if err == nil {
+		err = r.client.WaitForControlOperation(ctx, operationID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Disabling Control",
+			fmt.Sprintf("Could not disable control %s on %s: %s", controlIdentifier, targetIdentifier, err.Error()),
+		)
+	}
+}
+
+// ImportState imports an enabled control by "<control_arn>,<target_arn>".
+func (r *enabledControlResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ",")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import Identifier",
+			fmt.Sprintf("Expected import identifier with format <control_arn>,<target_arn>, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("control_identifier"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("target_identifier"), parts[1])...)
+}
+
+// setEnabledControlState maps the enabled control details onto the model. The
+// identifiers are only taken from AWS when the model has none, since AWS
+// returns them in its canonical form rather than as configured.
+func setEnabledControlState(ctx context.Context, model *enabledControlResourceModel, info *client.EnabledControlInfo) diag.Diagnostics {
+	if model.ControlIdentifier.IsNull() {
+		model.ControlIdentifier = types.StringValue(info.ControlIdentifier)
+	}
+	if model.TargetIdentifier.IsNull() {
+		model.TargetIdentifier = types.StringValue(info.TargetIdentifier)
+	}
+	model.Id = types.StringValue(model.ControlIdentifier.ValueString() + "," + model.TargetIdentifier.ValueString())
+	model.Arn = types.StringValue(info.Arn)
+	parameters, diags := jsonMapFromStrings(ctx, model.Parameters, info.Parameters)
+	model.Parameters = parameters
+	model.Status = types.StringValue(info.Status)
+	model.DriftStatus = types.StringValue(info.DriftStatus)
+
+	return diags
+}
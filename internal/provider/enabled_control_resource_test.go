@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/eaglespirittech/terraform-provider-controltowermanagement/internal/client"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEnabledControlResource(t *testing.T) {
+	testAccPreCheck(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckEnv(t, "CONTROLTOWER_TARGET_OU_ARN")
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnabledControlResourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("controltowermanagement_enabled_control.test", "arn"),
+					resource.TestCheckResourceAttr("controltowermanagement_enabled_control.test", "target_identifier", os.Getenv("CONTROLTOWER_TARGET_OU_ARN")),
+					resource.TestCheckResourceAttr("controltowermanagement_enabled_control.test", "status", "SUCCEEDED"),
+				),
+			},
+			{
+				ResourceName:      "controltowermanagement_enabled_control.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccEnabledControlResourceConfig() string {
+	partition, _ := client.PartitionForRegion(os.Getenv("AWS_REGION"))
+	return testAccProviderConfig() + `
+resource "controltowermanagement_enabled_control" "test" {
+  control_identifier = "arn:` + partition + `:controltower:` + os.Getenv("AWS_REGION") + `::control/AWS-GR_RESTRICT_ROOT_USER_ACCESS_KEYS"
+  target_identifier  = "` + os.Getenv("CONTROLTOWER_TARGET_OU_ARN") + `"
+}
+`
+}
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ basetypes.StringTypable                    = jsonStringType{}
+	_ basetypes.StringValuableWithSemanticEquals = jsonStringValue{}
+)
+
+// jsonStringType is a string type holding a JSON document. Values that only
+// differ in formatting are semantically equal, so the framework keeps the
+// prior value instead of reporting a difference after apply or refresh.
+type jsonStringType struct {
+	basetypes.StringType
+}
+
+// Equal returns true if the given type is equivalent.
+func (t jsonStringType) Equal(o attr.Type) bool {
+	other, ok := o.(jsonStringType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+// String returns a human readable string of the type name.
+func (t jsonStringType) String() string {
+	return "jsonStringType"
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t jsonStringType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return jsonStringValue{StringValue: in}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.
+func (t jsonStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return jsonStringValue{StringValue: stringValue}, nil
+}
+
+// ValueType returns the Value type.
+func (t jsonStringType) ValueType(_ context.Context) attr.Value {
+	return jsonStringValue{}
+}
+
+// jsonStringValue is a value of jsonStringType.
+type jsonStringValue struct {
+	basetypes.StringValue
+}
+
+// Equal returns true if the given value is equivalent.
+func (v jsonStringValue) Equal(o attr.Value) bool {
+	other, ok := o.(jsonStringValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// Type returns the type of the value.
+func (v jsonStringValue) Type(_ context.Context) attr.Type {
+	return jsonStringType{}
+}
+
+// StringSemanticEquals reports whether both values hold the same JSON
+// document.
+func (v jsonStringValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(jsonStringValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return jsonEqual(v.ValueString(), newValue.ValueString()), diags
+}
+
+// jsonMapFromStrings builds the map of JSON-encoded strings read from AWS.
+// A null prior value stays null, since parameters AWS fills in with their
+// defaults were not configured.
+func jsonMapFromStrings(ctx context.Context, prior types.Map, remote map[string]string) (types.Map, diag.Diagnostics) {
+	if prior.IsNull() {
+		return prior, nil
+	}
+
+	elementType, ok := prior.ElementType(ctx).(basetypes.StringTypable)
+	if !ok {
+		elementType = types.StringType
+	}
+
+	var diags diag.Diagnostics
+	elements := make(map[string]attr.Value, len(remote))
+	for key, value := range remote {
+		element, elementDiags := elementType.ValueFromString(ctx, types.StringValue(value))
+		diags.Append(elementDiags...)
+		elements[key] = element
+	}
+	if diags.HasError() {
+		return prior, diags
+	}
+
+	result, mapDiags := types.MapValue(prior.ElementType(ctx), elements)
+	diags.Append(mapDiags...)
+	return result, diags
+}
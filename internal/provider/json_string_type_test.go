@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONStringSemanticEquals(t *testing.T) {
+	prior := jsonStringValue{StringValue: types.StringValue(`["us-east-1","us-west-2"]`)}
+
+	testCases := map[string]struct {
+		value    string
+		expected bool
+	}{
+		"formatting only": {
+			value:    `[ "us-east-1", "us-west-2" ]`,
+			expected: true,
+		},
+		"changed value": {
+			value:    `["eu-west-1"]`,
+			expected: false,
+		},
+		"invalid JSON": {
+			value:    `[`,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			equal, diags := prior.StringSemanticEquals(context.Background(), jsonStringValue{StringValue: types.StringValue(testCase.value)})
+			assert.False(t, diags.HasError())
+			assert.Equal(t, testCase.expected, equal)
+		})
+	}
+}
+
+func TestJSONMapFromStrings(t *testing.T) {
+	ctx := context.Background()
+	remote := map[string]string{"AllowedRegions": `["us-east-1"]`}
+
+	// Parameters AWS reports with their defaults stay unset when not configured
+	result, diags := jsonMapFromStrings(ctx, types.MapNull(jsonStringType{}), remote)
+	assert.False(t, diags.HasError())
+	assert.True(t, result.IsNull())
+
+	prior := types.MapValueMust(jsonStringType{}, map[string]attr.Value{
+		"AllowedRegions": jsonStringValue{StringValue: types.StringValue(`[ "us-east-1" ]`)},
+	})
+	result, diags = jsonMapFromStrings(ctx, prior, remote)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, types.MapValueMust(jsonStringType{}, map[string]attr.Value{
+		"AllowedRegions": jsonStringValue{StringValue: types.StringValue(`["us-east-1"]`)},
+	}), result)
+
+	result, diags = jsonMapFromStrings(ctx, prior, nil)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, types.MapValueMust(jsonStringType{}, map[string]attr.Value{}), result)
+}
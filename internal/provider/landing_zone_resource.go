@@ -0,0 +1,349 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/eaglespirittech/terraform-provider-controltowermanagement/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ resource.Resource                   = &landingZoneResource{}
+	_ resource.ResourceWithConfigure      = &landingZoneResource{}
+	_ resource.ResourceWithImportState    = &landingZoneResource{}
+	_ resource.ResourceWithModifyPlan     = &landingZoneResource{}
+	_ resource.ResourceWithValidateConfig = &landingZoneResource{}
+)
+
+// landingZoneResource is the resource implementation.
+type landingZoneResource struct {
+	client *client.Client
+}
+
+// landingZoneResourceModel describes the resource data model.
+type landingZoneResourceModel struct {
+	Id                     types.String `tfsdk:"id"`
+	Arn                    types.String `tfsdk:"arn"`
+	Version                types.String `tfsdk:"version"`
+	ManifestJson           types.String `tfsdk:"manifest_json"`
+	ResetOnDrift           types.Bool   `tfsdk:"reset_on_drift"`
+	Status                 types.String `tfsdk:"status"`
+	DriftStatus            types.String `tfsdk:"drift_status"`
+	LatestAvailableVersion types.String `tfsdk:"latest_available_version"`
+}
+
+// NewLandingZoneResource is a helper function to simplify the provider implementation.
+func NewLandingZoneResource() resource.Resource {
+	return &landingZoneResource{}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *landingZoneResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *landingZoneResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_landing_zone"
+}
+
+// Schema defines the schema for the resource.
+func (r *landingZoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the AWS Control Tower landing zone of the organization.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ARN of the landing zone",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"arn": schema.StringAttribute{
+				Description: "The ARN of the landing zone",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version": schema.StringAttribute{
+				Description: "The landing zone version (e.g., 3.3)",
+				Required:    true,
+			},
+			"manifest_json": schema.StringAttribute{
+				Description: "The landing zone manifest as a JSON document",
+				Required:    true,
+			},
+			"reset_on_drift": schema.BoolAttribute{
+				Description: "Whether to reset the landing zone when Control Tower reports it as drifted",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the landing zone (ACTIVE, PROCESSING, FAILED)",
+				Computed:    true,
+			},
+			"drift_status": schema.StringAttribute{
+				Description: "The drift status of the landing zone (DRIFTED, IN_SYNC)",
+				Computed:    true,
+			},
+			"latest_available_version": schema.StringAttribute{
+				Description: "The latest landing zone version available for update",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig ensures the manifest is a valid JSON document.
+func (r *landingZoneResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var manifest types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("manifest_json"), &manifest)...)
+	if resp.Diagnostics.HasError() || manifest.IsNull() || manifest.IsUnknown() {
+		return
+	}
+
+	var v interface{}
+	if err := json.Unmarshal([]byte(manifest.ValueString()), &v); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("manifest_json"),
+			"Invalid Landing Zone Manifest",
+			"The manifest must be a valid JSON document: "+err.Error(),
+		)
+	}
+}
+
+// ModifyPlan plans a reset when the landing zone has drifted and reset_on_drift is enabled.
+func (r *landingZoneResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan landingZoneResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Plan the attributes a reset can change as unknown, which also makes the
+	// reset show up as an update
+	if plan.ResetOnDrift.ValueBool() && state.DriftStatus.ValueString() == "DRIFTED" {
+		for _, attribute := range []string{"status", "drift_status", "latest_available_version"} {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attribute), types.StringUnknown())...)
+		}
+	}
+}
+
+// Create creates the landing zone and waits for the operation to complete.
+func (r *landingZoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan landingZoneResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	arn, operationID, err := r.client.CreateLandingZone(ctx, plan.Version.ValueString(), plan.ManifestJson.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Landing Zone",
+			fmt.Sprintf("Could not create landing zone: %s", err.Error()),
+		)
+		return
+	}
+
+	// Persist the ARN so a failed operation leaves a tainted resource rather than an orphan
+	plan.Id = types.StringValue(arn)
+	plan.Arn = types.StringValue(arn)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.Id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("arn"), plan.Arn)...)
+
+	if err := r.client.WaitForLandingZoneOperation(ctx, operationID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Landing Zone",
+			fmt.Sprintf("Landing zone %s did not finish creating: %s", arn, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.refresh(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *landingZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state landingZoneResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	info, err := r.client.GetLandingZone(ctx, state.Id.ValueString())
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Landing Zone",
+			fmt.Sprintf("Could not read landing zone %s: %s", state.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	setLandingZoneState(&state, info)
+	if state.ResetOnDrift.IsNull() {
+		state.ResetOnDrift = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the landing zone manifest or version, resetting it first when drifted.
+func (r *landingZoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state landingZoneResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identifier := state.Id.ValueString()
+
+	if !plan.Version.Equal(state.Version) || !jsonEqual(plan.ManifestJson.ValueString(), state.ManifestJson.ValueString()) {
+		operationID, err := r.client.UpdateLandingZone(ctx, identifier, plan.Version.ValueString(), plan.ManifestJson.ValueString())
+		if err == nil {
+			err = r.client.WaitForLandingZoneOperation(ctx, operationID)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Landing Zone",
+				fmt.Sprintf("Could not update landing zone %s: %s", identifier, err.Error()),
+			)
+			return
+		}
+	} else if plan.ResetOnDrift.ValueBool() && state.DriftStatus.ValueString() == "DRIFTED" {
+		operationID, err := r.client.ResetLandingZone(ctx, identifier)
+		if err == nil {
+			err = r.client.WaitForLandingZoneOperation(ctx, operationID)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Resetting Landing Zone",
+				fmt.Sprintf("Could not reset landing zone %s: %s", identifier, err.Error()),
+			)
+			return
+		}
+	}
+
+	plan.Id = state.Id
+	plan.Arn = state.Arn
+	resp.Diagnostics.Append(r.refresh(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete decommissions the landing zone and waits for the operation to complete.
+func (r *landingZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state landingZoneResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operationID, err := r.client.DeleteLandingZone(ctx, state.Id.ValueString())
+	if client.IsNotFound(err) {
+		return
+	}
+	if err == nil {
+		err = r.client.WaitForLandingZoneOperation(ctx, operationID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Landing Zone",
+			fmt.Sprintf("Could not delete landing zone %s: %s", state.Id.ValueString(), err.Error()),
+		)
+	}
+}
+
+// ImportState imports a landing zone by its ARN.
+func (r *landingZoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("reset_on_drift"), types.BoolValue(false))...)
+}
+
+// refresh reads the landing zone back into the model after a create or update.
+func (r *landingZoneResource) refresh(ctx context.Context, model *landingZoneResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	info, err := r.client.GetLandingZone(ctx, model.Id.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error Reading Landing Zone",
+			fmt.Sprintf("Could not read landing zone %s: %s", model.Id.ValueString(), err.Error()),
+		)
+		return diags
+	}
+
+	setLandingZoneState(model, info)
+	return diags
+}
+
+// setLandingZoneState maps the landing zone details onto the model, keeping the
+// configured manifest when it is semantically equal to the remote one.
+func setLandingZoneState(model *landingZoneResourceModel, info *client.LandingZoneInfo) {
+	model.Id = types.StringValue(info.Arn)
+	model.Arn = types.StringValue(info.Arn)
+	model.Version = types.StringValue(info.Version)
+	model.Status = types.StringValue(info.Status)
+	model.DriftStatus = types.StringValue(info.DriftStatus)
+	model.LatestAvailableVersion = types.StringValue(info.LatestAvailableVersion)
+
+	if model.ManifestJson.IsNull() || !jsonEqual(model.ManifestJson.ValueString(), info.Manifest) {
+		model.ManifestJson = types.StringValue(info.Manifest)
+	}
+}
+
+// jsonEqual reports whether two JSON documents are semantically equal.
+func jsonEqual(a, b string) bool {
+	var va, vb interface{}
+	if err := json.Unmarshal([]byte(a), &va); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(b), &vb); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLandingZoneResource(t *testing.T) {
+	testAccPreCheck(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckEnv(t, "CONTROLTOWER_LANDING_ZONE_MANIFEST_FILE")
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLandingZoneResourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("controltowermanagement_landing_zone.test", "arn"),
+					resource.TestCheckResourceAttr("controltowermanagement_landing_zone.test", "version", "3.3"),
+					resource.TestCheckResourceAttr("controltowermanagement_landing_zone.test", "status", "ACTIVE"),
+				),
+			},
+			{
+				ResourceName:            "controltowermanagement_landing_zone.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"manifest_json"},
+			},
+		},
+	})
+}
+
+func testAccLandingZoneResourceConfig() string {
+	return testAccProviderConfig() + `
+resource "controltowermanagement_landing_zone" "test" {
+  version       = "3.3"
+  manifest_json = file("` + os.Getenv("CONTROLTOWER_LANDING_ZONE_MANIFEST_FILE") + `")
+}
+`
+}
@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/eaglespirittech/terraform-provider-controltowermanagement/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ resource.Resource                = &organizationalUnitResource{}
+	_ resource.ResourceWithConfigure   = &organizationalUnitResource{}
+	_ resource.ResourceWithImportState = &organizationalUnitResource{}
+)
+
+// organizationalUnitResource is the resource implementation.
+type organizationalUnitResource struct {
+	client *client.Client
+}
+
+// organizationalUnitResourceModel describes the resource data model.
+type organizationalUnitResourceModel struct {
+	Id       types.String `tfsdk:"id"`
+	Arn      types.String `tfsdk:"arn"`
+	Name     types.String `tfsdk:"name"`
+	ParentId types.String `tfsdk:"parent_id"`
+}
+
+// NewOrganizationalUnitResource is a helper function to simplify the provider implementation.
+func NewOrganizationalUnitResource() resource.Resource {
+	return &organizationalUnitResource{}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *organizationalUnitResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *organizationalUnitResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organizational_unit"
+}
+
+// Schema defines the schema for the resource.
+func (r *organizationalUnitResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an organizational unit in AWS Organizations.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the organizational unit (e.g., ou-abcd-12345678)",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"arn": schema.StringAttribute{
+				Description: "The ARN of the organizational unit",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the organizational unit. Changing the name renames the organizational unit in place.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"parent_id": schema.StringAttribute{
+				Description: "The ID of the root or organizational unit that contains this organizational unit",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the organizational unit.
+func (r *organizationalUnitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan organizationalUnitResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	parentID := plan.ParentId.ValueString()
+
+	info, err := r.client.CreateOrganizationalUnit(ctx, parentID, name)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Organizational Unit",
+			fmt.Sprintf("Could not create organizational unit %s under %s: %s", name, parentID, err.Error()),
+		)
+		return
+	}
+
+	setOrganizationalUnitState(&plan, info)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *organizationalUnitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state organizationalUnitResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	info, err := r.client.GetOrganizationalUnit(ctx, state.Id.ValueString())
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Organizational Unit",
+			fmt.Sprintf("Could not read organizational unit %s: %s", state.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	setOrganizationalUnitState(&state, info)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update renames the organizational unit in place.
+func (r *organizationalUnitResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state organizationalUnitResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ouID := state.Id.ValueString()
+	if err := r.client.RenameOrganizationalUnit(ctx, ouID, plan.Name.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Organizational Unit",
+			fmt.Sprintf("Could not rename organizational unit %s: %s", ouID, err.Error()),
+		)
+		return
+	}
+
+	info, err := r.client.GetOrganizationalUnit(ctx, ouID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Organizational Unit",
+			fmt.Sprintf("Could not read organizational unit %s: %s", ouID, err.Error()),
+		)
+		return
+	}
+
+	setOrganizationalUnitState(&plan, info)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the organizational unit. AWS Organizations only allows
+// deleting organizational units that contain no accounts or child units.
+func (r *organizationalUnitResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state organizationalUnitResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteOrganizationalUnit(ctx, state.Id.ValueString())
+	if client.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Organizational Unit",
+			fmt.Sprintf("Could not delete organizational unit %s: %s", state.Id.ValueString(), err.Error()),
+		)
+	}
+}
+
+// ImportState imports an organizational unit by its ID.
+func (r *organizationalUnitResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setOrganizationalUnitState maps the organizational unit details onto the model.
+func setOrganizationalUnitState(model *organizationalUnitResourceModel, info *client.OrganizationalUnitInfo) {
+	model.Id = types.StringValue(info.Id)
+	model.Arn = types.StringValue(info.Arn)
+	model.Name = types.StringValue(info.Name)
+	model.ParentId = types.StringValue(info.ParentId)
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationalUnitResource(t *testing.T) {
+	testAccPreCheck(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckEnv(t, "CONTROLTOWER_ORGANIZATION_ROOT_ID")
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationalUnitResourceConfig("tf-acc-test-ou"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("controltowermanagement_organizational_unit.test", "id"),
+					resource.TestCheckResourceAttrSet("controltowermanagement_organizational_unit.test", "arn"),
+					resource.TestCheckResourceAttr("controltowermanagement_organizational_unit.test", "name", "tf-acc-test-ou"),
+				),
+			},
+			{
+				Config: testAccOrganizationalUnitResourceConfig("tf-acc-test-ou-renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("controltowermanagement_organizational_unit.test", "name", "tf-acc-test-ou-renamed"),
+				),
+			},
+			{
+				ResourceName:      "controltowermanagement_organizational_unit.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccOrganizationalUnitResourceConfig(name string) string {
+	return testAccProviderConfig() + `
+resource "controltowermanagement_organizational_unit" "test" {
+  name      = "` + name + `"
+  parent_id = "` + os.Getenv("CONTROLTOWER_ORGANIZATION_ROOT_ID") + `"
+}
+`
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/eaglespirittech/terraform-provider-controltowermanagement/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ datasource.DataSource              = &organizationalUnitsDataSource{}
+	_ datasource.DataSourceWithConfigure = &organizationalUnitsDataSource{}
+)
+
+// organizationalUnitsDataSource is the data source implementation.
+type organizationalUnitsDataSource struct {
+	client *client.Client
+}
+
+// organizationalUnitsDataSourceModel describes the data source data model.
+type organizationalUnitsDataSourceModel struct {
+	RootId              types.String              `tfsdk:"root_id"`
+	OrganizationalUnits []organizationalUnitModel `tfsdk:"organizational_units"`
+}
+
+// organizationalUnitModel describes an organizational unit in the tree.
+type organizationalUnitModel struct {
+	Id       types.String `tfsdk:"id"`
+	Arn      types.String `tfsdk:"arn"`
+	Name     types.String `tfsdk:"name"`
+	ParentId types.String `tfsdk:"parent_id"`
+	Depth    types.Int64  `tfsdk:"depth"`
+	Path     types.String `tfsdk:"path"`
+}
+
+// NewOrganizationalUnitsDataSource is a helper function to simplify the provider implementation.
+func NewOrganizationalUnitsDataSource() datasource.DataSource {
+	return &organizationalUnitsDataSource{}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *organizationalUnitsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *organizationalUnitsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organizational_units"
+}
+
+// Schema defines the schema for the data source.
+func (d *organizationalUnitsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to get every organizational unit in your organization, walked from the root.",
+		Attributes: map[string]schema.Attribute{
+			"root_id": schema.StringAttribute{
+				Description: "The ID of the organization root",
+				Computed:    true,
+			},
+			"organizational_units": schema.ListNestedAttribute{
+				Description: "List of organizational units in depth-first order",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the organizational unit",
+							Computed:    true,
+						},
+						"arn": schema.StringAttribute{
+							Description: "The ARN of the organizational unit",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the organizational unit",
+							Computed:    true,
+						},
+						"parent_id": schema.StringAttribute{
+							Description: "The ID of the root or organizational unit that contains this organizational unit",
+							Computed:    true,
+						},
+						"depth": schema.Int64Attribute{
+							Description: "The depth of the organizational unit, 1 for units directly under the root",
+							Computed:    true,
+						},
+						"path": schema.StringAttribute{
+							Description: "The names from the root down to this organizational unit joined by slashes (e.g., Root/Workloads/Prod)",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *organizationalUnitsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state organizationalUnitsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client == nil {
+		resp.Diagnostics.AddError(
+			"Client Not Configured",
+			"Expected configured client but got nil. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	root, units, err := d.client.ListOrganizationalUnitTree(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Organizational Units",
+			fmt.Sprintf("Could not read organizational units: %s\n\nCheck that the credentials have the organizations:ListRoots and organizations:ListOrganizationalUnitsForParent permissions.", err.Error()),
+		)
+		return
+	}
+
+	state.RootId = types.StringValue(root.Id)
+	state.OrganizationalUnits = make([]organizationalUnitModel, 0, len(units))
+	for _, unit := range units {
+		state.OrganizationalUnits = append(state.OrganizationalUnits, organizationalUnitModel{
+			Id:       types.StringValue(unit.Id),
+			Arn:      types.StringValue(unit.Arn),
+			Name:     types.StringValue(unit.Name),
+			ParentId: types.StringValue(unit.ParentId),
+			Depth:    types.Int64Value(int64(unit.Depth)),
+			Path:     types.StringValue(unit.Path),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
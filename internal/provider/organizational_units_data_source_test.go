@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationalUnitsDataSource(t *testing.T) {
+	testAccPreCheck(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationalUnitsDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.controltowermanagement_organizational_units.test", "root_id"),
+					resource.TestCheckResourceAttrSet("data.controltowermanagement_organizational_units.test", "organizational_units.#"),
+					resource.TestCheckResourceAttrSet("data.controltowermanagement_organizational_units.test", "organizational_units.0.id"),
+					resource.TestCheckResourceAttr("data.controltowermanagement_organizational_units.test", "organizational_units.0.depth", "1"),
+					resource.TestCheckResourceAttrSet("data.controltowermanagement_organizational_units.test", "organizational_units.0.path"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationalUnitsDataSourceConfig() string {
+	return testAccProviderConfig() + `
+data "controltowermanagement_organizational_units" "test" {}
+`
+}
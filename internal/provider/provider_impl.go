@@ -2,12 +2,21 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"os"
 	"regexp"
+	"time"
 
 	"github.com/eaglespirittech/terraform-provider-controltowermanagement/internal/client"
 	"github.com/eaglespirittech/terraform-provider-controltowermanagement/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -31,50 +40,64 @@ func (p *controltowermanagementProvider) Schema(ctx context.Context, req provide
 		Blocks: map[string]schema.Block{
 			"assume_role": schema.SingleNestedBlock{
 				Description: "Assume role configuration block",
+				Attributes:  assumeRoleAttributes(),
+			},
+			"assume_role_chain": schema.ListNestedBlock{
+				Description: "Further roles to assume after assume_role, in the order given, each hop using the credentials of the previous one.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: assumeRoleAttributes(),
+				},
+			},
+			"assume_role_with_web_identity": schema.SingleNestedBlock{
+				Description: "Assume role with an OIDC web identity token, e.g. from a CI pipeline. No other AWS credentials are needed; assume_role and assume_role_chain, if any, are applied on top of the resulting session.",
 				Attributes: map[string]schema.Attribute{
 					"role_arn": schema.StringAttribute{
 						Description: "ARN of the role to assume",
 						Optional:    true,
-						Validators: []validator.String{
-							validators.RegexMatches(
-								regexp.MustCompile(`^arn:aws:iam::\d{12}:role/[a-zA-Z0-9_+=,.@-]+$`),
-								"Role ARN must be a valid AWS IAM role ARN",
-							),
-						},
 					},
 					"session_name": schema.StringAttribute{
 						Description: "Session name to use when assuming the role",
 						Optional:    true,
 					},
-					"external_id": schema.StringAttribute{
-						Description: "External ID to use when assuming the role",
-						Optional:    true,
-					},
-					"duration_seconds": schema.Int64Attribute{
-						Description: "Duration of the assumed role session in seconds",
-						Optional:    true,
-					},
-					"policy_arns": schema.ListAttribute{
-						Description: "List of ARNs of IAM policies to use for the assumed role session",
-						ElementType: types.StringType,
-						Optional:    true,
-					},
-					"policy": schema.StringAttribute{
-						Description: "IAM policy document to use for the assumed role session",
+					"web_identity_token": schema.StringAttribute{
+						Description: "OIDC web identity token. Conflicts with web_identity_token_file.",
 						Optional:    true,
+						Sensitive:   true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("web_identity_token_file")),
+						},
 					},
-					"tags": schema.MapAttribute{
-						Description: "Map of tags to use for the assumed role session",
-						ElementType: types.StringType,
+					"web_identity_token_file": schema.StringAttribute{
+						Description: "Path to a file containing the OIDC web identity token. Conflicts with web_identity_token.",
 						Optional:    true,
 					},
-					"transitive_tag_keys": schema.ListAttribute{
-						Description: "List of tag keys to pass to the assumed role session",
-						ElementType: types.StringType,
+					"duration_seconds": schema.Int64Attribute{
+						Description: "Duration of the assumed role session in seconds",
 						Optional:    true,
 					},
 				},
 			},
+			"endpoints": schema.SingleNestedBlock{
+				Description: "Custom service endpoint URLs, e.g. VPC interface endpoints or local stand-ins for testing. Services without an endpoint use the default.",
+				Attributes: map[string]schema.Attribute{
+					"organizations":  endpointAttribute("AWS Organizations"),
+					"sts":            endpointAttribute("AWS STS"),
+					"controltower":   endpointAttribute("AWS Control Tower"),
+					"servicecatalog": endpointAttribute("AWS Service Catalog"),
+					"cloudformation": endpointAttribute("AWS CloudFormation"),
+					"sso":            endpointAttribute("AWS IAM Identity Center (SSO) portal"),
+				},
+			},
+			"rate_limits": schema.SingleNestedBlock{
+				Description: "Maximum requests per second sent to each service, shared by all resources and data sources of this provider instance regardless of Terraform parallelism. Services without a limit are not throttled client-side.",
+				Attributes: map[string]schema.Attribute{
+					"organizations":  rateLimitAttribute("AWS Organizations"),
+					"sts":            rateLimitAttribute("AWS STS"),
+					"controltower":   rateLimitAttribute("AWS Control Tower"),
+					"servicecatalog": rateLimitAttribute("AWS Service Catalog"),
+					"cloudformation": rateLimitAttribute("AWS CloudFormation"),
+				},
+			},
 		},
 		Attributes: map[string]schema.Attribute{
 			"access_key": schema.StringAttribute{
@@ -89,13 +112,70 @@ func (p *controltowermanagementProvider) Schema(ctx context.Context, req provide
 			"region": schema.StringAttribute{
 				Description: "AWS Region. Can be set via AWS_REGION environment variable.",
 				Optional:    true,
+			},
+			"profile": schema.StringAttribute{
+				Description: "Name of the AWS profile in the shared config and credentials files. Can be set via AWS_PROFILE environment variable. access_key and secret_key take precedence over the profile credentials.",
+				Optional:    true,
+			},
+			"shared_config_files": schema.ListAttribute{
+				Description: "List of paths to AWS shared config files. Defaults to ~/.aws/config.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"shared_credentials_files": schema.ListAttribute{
+				Description: "List of paths to AWS shared credentials files. Defaults to ~/.aws/credentials.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"credential_process": schema.StringAttribute{
+				Description: "External command that prints credentials in the credential_process JSON format. The command is run again when the credentials expire. Takes precedence over the profile credentials and conflicts with access_key.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("access_key")),
+				},
+			},
+			"allowed_account_ids": schema.SetAttribute{
+				Description: "List of AWS account IDs the provider may operate on. Configuration fails when the credentials, after any role assumption, resolve to another account. Conflicts with forbidden_account_ids.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("forbidden_account_ids")),
+					setvalidator.ValueStringsAre(accountIdValidator()),
+				},
+			},
+			"forbidden_account_ids": schema.SetAttribute{
+				Description: "List of AWS account IDs the provider must not operate on. Configuration fails when the credentials, after any role assumption, resolve to one of them. Conflicts with allowed_account_ids.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(accountIdValidator()),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of times an AWS call is retried after throttling or transient errors. Defaults to the AWS SDK default of 2.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_mode": schema.StringAttribute{
+				Description: "Retry mode of the AWS SDK, standard or adaptive. adaptive additionally slows down requests client-side after throttling. Defaults to standard.",
+				Optional:    true,
 				Validators: []validator.String{
-					validators.RegexMatches(
-						regexp.MustCompile(`^[a-z]{2}-[a-z]+-\d{1}$`),
-						"Region must be a valid AWS region (e.g., us-west-2)",
-					),
+					stringvalidator.OneOf(client.RetryModeStandard, client.RetryModeAdaptive),
 				},
 			},
+			"max_backoff_seconds": schema.Int64Attribute{
+				Description: "Maximum delay in seconds between retries of an AWS call. Defaults to the AWS SDK default of 20 seconds.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"require_management_account": schema.BoolAttribute{
+				Description: "When true, configuration fails unless the credentials, after any role assumption, belong to the organization management account or a delegated administrator account. Defaults to false.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -108,52 +188,79 @@ func (p *controltowermanagementProvider) Configure(ctx context.Context, req prov
 		return
 	}
 
-	// Get credentials from environment variables if not set in configuration
-	if config.AccessKey.IsNull() {
+	// Get credentials from environment variables if not set in configuration.
+	// A profile or credential_process set in the configuration wins over keys
+	// from the environment.
+	useEnvKeys := config.Profile.IsNull() && config.CredentialProcess.IsNull()
+	if config.AccessKey.IsNull() && useEnvKeys {
 		accessKey := os.Getenv("AWS_ACCESS_KEY")
 		if accessKey == "" {
 			accessKey = os.Getenv("AWS_ACCESS_KEY_ID")
 		}
 		config.AccessKey = types.StringValue(accessKey)
 	}
-	if config.SecretKey.IsNull() {
+	if config.SecretKey.IsNull() && useEnvKeys {
 		config.SecretKey = types.StringValue(os.Getenv("AWS_SECRET_ACCESS_KEY"))
 	}
 	if config.Region.IsNull() {
 		config.Region = types.StringValue(os.Getenv("AWS_REGION"))
 	}
-
-	// Validate required credentials
-	if config.AccessKey.ValueString() == "" && config.AssumeRole == nil {
-		resp.Diagnostics.AddError(
-			"Missing AWS Credentials",
-			"Either provide access_key/secret_key or assume_role configuration",
-		)
+	if config.Profile.IsNull() {
+		config.Profile = types.StringValue(os.Getenv("AWS_PROFILE"))
 	}
-	if config.SecretKey.ValueString() == "" && config.AssumeRole == nil {
+
+	// Without static keys the SDK default credential chain is used
+	if config.AccessKey.ValueString() != "" && config.SecretKey.ValueString() == "" {
 		resp.Diagnostics.AddError(
 			"Missing AWS Secret Key",
 			"Either set AWS_SECRET_ACCESS_KEY environment variable or provide secret_key in provider configuration",
 		)
 	}
-	if config.Region.ValueString() == "" {
-		resp.Diagnostics.AddError(
-			"Missing AWS Region",
-			"Either set AWS_REGION environment variable or provide region in provider configuration",
-		)
+
+	clientConfig := &client.ClientConfig{
+		AccessKey:         config.AccessKey.ValueString(),
+		SecretKey:         config.SecretKey.ValueString(),
+		SessionToken:      os.Getenv("AWS_SESSION_TOKEN"),
+		Region:            config.Region.ValueString(),
+		Profile:           config.Profile.ValueString(),
+		CredentialProcess: config.CredentialProcess.ValueString(),
+	}
+	if !config.MaxRetries.IsNull() {
+		clientConfig.MaxAttempts = int(config.MaxRetries.ValueInt64()) + 1
 	}
+	clientConfig.RetryMode = config.RetryMode.ValueString()
+	clientConfig.MaxBackoff = time.Duration(config.MaxBackoffSeconds.ValueInt64()) * time.Second
+	if config.RateLimits != nil {
+		clientConfig.RateLimits = client.RateLimits{
+			Organizations:  config.RateLimits.Organizations.ValueFloat64(),
+			STS:            config.RateLimits.STS.ValueFloat64(),
+			ControlTower:   config.RateLimits.ControlTower.ValueFloat64(),
+			ServiceCatalog: config.RateLimits.ServiceCatalog.ValueFloat64(),
+			CloudFormation: config.RateLimits.CloudFormation.ValueFloat64(),
+		}
+	}
+	if config.Endpoints != nil {
+		clientConfig.Endpoints = client.Endpoints{
+			Organizations:  config.Endpoints.Organizations.ValueString(),
+			STS:            config.Endpoints.STS.ValueString(),
+			ControlTower:   config.Endpoints.ControlTower.ValueString(),
+			ServiceCatalog: config.Endpoints.ServiceCatalog.ValueString(),
+			CloudFormation: config.Endpoints.CloudFormation.ValueString(),
+			SSO:            config.Endpoints.SSO.ValueString(),
+		}
+	}
+	clientConfig.SharedConfigFiles, diags = stringListValue(ctx, config.SharedConfigFiles)
+	resp.Diagnostics.Append(diags...)
+	clientConfig.SharedCredentialsFiles, diags = stringListValue(ctx, config.SharedCredentialsFiles)
+	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Initialize AWS client with session token if available
-	sessionToken := os.Getenv("AWS_SESSION_TOKEN")
-	awsClient, err := client.NewClient(
-		config.AccessKey.ValueString(),
-		config.SecretKey.ValueString(),
-		config.Region.ValueString(),
-	)
+	// Initialize AWS client from static keys, credential_process, the shared
+	// config profile or the default credential chain
+	awsClient, err := client.NewClientFromConfig(ctx, clientConfig)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to create AWS client",
@@ -162,73 +269,161 @@ func (p *controltowermanagementProvider) Configure(ctx context.Context, req prov
 		return
 	}
 
-	// Set session token if available
-	if sessionToken != "" {
-		if err := awsClient.SetSessionToken(sessionToken); err != nil {
-			resp.Diagnostics.AddError(
-				"Failed to set session token",
-				"Error setting session token: "+err.Error(),
-			)
-			return
-		}
+	// The region may also come from the shared config profile
+	if awsClient.Region() == "" {
+		resp.Diagnostics.AddError(
+			"Missing AWS Region",
+			"Either set AWS_REGION environment variable, provide region in provider configuration or set region in the AWS profile",
+		)
+		return
 	}
 
-	// Handle assume role if configured
+	// The region decides the partition that role ARNs must belong to
+	if err := awsClient.VerifyRegion(); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("region"),
+			"Invalid AWS Region",
+			err.Error(),
+		)
+		return
+	}
+
+	// Roles can only be assumed within the partition of the region
+	var roleArnPaths []path.Path
+	var roleArns []string
+	if config.AssumeRoleWithWebIdentity != nil {
+		roleArnPaths = append(roleArnPaths, path.Root("assume_role_with_web_identity").AtName("role_arn"))
+		roleArns = append(roleArns, config.AssumeRoleWithWebIdentity.RoleArn.ValueString())
+	}
 	if config.AssumeRole != nil {
-		// Skip assume role if role_arn is not provided
-		if config.AssumeRole.RoleArn.IsNull() {
-			return
+		roleArnPaths = append(roleArnPaths, path.Root("assume_role").AtName("role_arn"))
+		roleArns = append(roleArns, config.AssumeRole.RoleArn.ValueString())
+	}
+	for i := range config.AssumeRoleChain {
+		roleArnPaths = append(roleArnPaths, path.Root("assume_role_chain").AtListIndex(i).AtName("role_arn"))
+		roleArns = append(roleArns, config.AssumeRoleChain[i].RoleArn.ValueString())
+	}
+	for i, roleArn := range roleArns {
+		if roleArn == "" {
+			continue
 		}
-
-		assumeRoleConfig := &client.AssumeRoleConfig{
-			RoleArn: config.AssumeRole.RoleArn.ValueString(),
+		if err := awsClient.VerifyRoleArn(roleArn); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				roleArnPaths[i],
+				"Invalid Role ARN",
+				err.Error(),
+			)
 		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-		if !config.AssumeRole.SessionName.IsNull() {
-			assumeRoleConfig.SessionName = config.AssumeRole.SessionName.ValueString()
-		}
-		if !config.AssumeRole.ExternalId.IsNull() {
-			assumeRoleConfig.ExternalId = config.AssumeRole.ExternalId.ValueString()
+	if config.AssumeRoleWithWebIdentity != nil {
+		// The web identity token replaces any other credentials
+		webIdentityConfig, diags := newWebIdentityConfig(config.AssumeRoleWithWebIdentity)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
-		if !config.AssumeRole.DurationSeconds.IsNull() {
-			assumeRoleConfig.DurationSeconds = int32(config.AssumeRole.DurationSeconds.ValueInt64())
+
+		if err := awsClient.AssumeRoleWithWebIdentity(ctx, webIdentityConfig); err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to assume role with web identity",
+				"Error assuming role with web identity: "+err.Error(),
+			)
+			return
 		}
-		if !config.AssumeRole.Policy.IsNull() {
-			assumeRoleConfig.Policy = config.AssumeRole.Policy.ValueString()
+	} else if err := awsClient.VerifyCredentials(ctx); err != nil {
+		var ssoErr *client.SSOLoginRequiredError
+		if errors.As(err, &ssoErr) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("profile"),
+				"AWS SSO Session Expired",
+				err.Error(),
+			)
+			return
 		}
+		resp.Diagnostics.AddError(
+			"No Valid AWS Credentials Found",
+			err.Error(),
+		)
+		return
+	}
 
-		// Handle policy ARNs
-		if !config.AssumeRole.PolicyArns.IsNull() {
-			var policyArns []string
-			diags := config.AssumeRole.PolicyArns.ElementsAs(ctx, &policyArns, false)
-			if !diags.HasError() {
-				assumeRoleConfig.PolicyArns = policyArns
+	// Handle assume role if configured
+	assumeRoleConfigs, assumeRolePaths, diags := newAssumeRoleChain(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(assumeRoleConfigs) > 0 {
+		// Assume the roles in order
+		if err := awsClient.AssumeRoleChain(ctx, assumeRoleConfigs); err != nil {
+			var hopErr *client.AssumeRoleHopError
+			if errors.As(err, &hopErr) {
+				resp.Diagnostics.AddAttributeError(
+					assumeRolePaths[hopErr.Hop],
+					"Failed to assume role",
+					fmt.Sprintf("Error assuming role %s (hop %d of %d): %s", hopErr.RoleArn, hopErr.Hop+1, len(assumeRoleConfigs), hopErr.Err.Error()),
+				)
+				return
 			}
+			resp.Diagnostics.AddError(
+				"Failed to assume role",
+				"Error assuming role: "+err.Error(),
+			)
+			return
 		}
+	}
 
-		// Handle tags
-		if !config.AssumeRole.Tags.IsNull() {
-			var tags map[string]string
-			diags := config.AssumeRole.Tags.ElementsAs(ctx, &tags, false)
-			if !diags.HasError() {
-				assumeRoleConfig.Tags = tags
-			}
-		}
+	// Refuse to operate on an account that is not allowed, now that the final
+	// credentials are known
+	allowedAccountIds, diags := stringSetValue(ctx, config.AllowedAccountIds)
+	resp.Diagnostics.Append(diags...)
+	forbiddenAccountIds, diags := stringSetValue(ctx, config.ForbiddenAccountIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-		// Handle transitive tag keys
-		if !config.AssumeRole.TransitiveTagKeys.IsNull() {
-			var tagKeys []string
-			diags := config.AssumeRole.TransitiveTagKeys.ElementsAs(ctx, &tagKeys, false)
-			if !diags.HasError() {
-				assumeRoleConfig.TransitiveTagKeys = tagKeys
+	if err := awsClient.VerifyAccountAllowed(ctx, allowedAccountIds, forbiddenAccountIds); err != nil {
+		var notAllowedErr *client.AccountNotAllowedError
+		if errors.As(err, &notAllowedErr) {
+			attributePath := path.Root("allowed_account_ids")
+			if notAllowedErr.Forbidden {
+				attributePath = path.Root("forbidden_account_ids")
 			}
+			resp.Diagnostics.AddAttributeError(
+				attributePath,
+				"AWS Account Not Allowed",
+				"Refusing to operate on this account: "+err.Error(),
+			)
+			return
 		}
+		resp.Diagnostics.AddError(
+			"Failed to verify AWS account",
+			"Error checking the account against allowed_account_ids and forbidden_account_ids: "+err.Error(),
+		)
+		return
+	}
 
-		// Assume the role
-		if err := awsClient.AssumeRole(ctx, assumeRoleConfig); err != nil {
+	// Fail early instead of deep inside the first Organizations or Control
+	// Tower call when running from a member account
+	if config.RequireManagementAccount.ValueBool() {
+		if err := awsClient.VerifyManagementAccount(ctx); err != nil {
+			var notManagementErr *client.NotManagementAccountError
+			if errors.As(err, &notManagementErr) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("require_management_account"),
+					"Not the Organization Management Account",
+					err.Error(),
+				)
+				return
+			}
 			resp.Diagnostics.AddError(
-				"Failed to assume role",
-				"Error assuming role: "+err.Error(),
+				"Failed to verify management account",
+				"Error checking that the caller is the organization management account: "+err.Error(),
 			)
 			return
 		}
@@ -239,12 +434,184 @@ func (p *controltowermanagementProvider) Configure(ctx context.Context, req prov
 	resp.ResourceData = awsClient
 }
 
+// assumeRoleAttributes returns the attributes shared by the assume_role and
+// assume_role_chain blocks
+func assumeRoleAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"role_arn": schema.StringAttribute{
+			Description: "ARN of the role to assume",
+			Optional:    true,
+		},
+		"session_name": schema.StringAttribute{
+			Description: "Session name to use when assuming the role",
+			Optional:    true,
+		},
+		"external_id": schema.StringAttribute{
+			Description: "External ID to use when assuming the role",
+			Optional:    true,
+		},
+		"duration_seconds": schema.Int64Attribute{
+			Description: "Duration of the assumed role session in seconds",
+			Optional:    true,
+		},
+		"policy_arns": schema.ListAttribute{
+			Description: "List of ARNs of IAM policies to use for the assumed role session",
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"policy": schema.StringAttribute{
+			Description: "IAM policy document to use for the assumed role session",
+			Optional:    true,
+		},
+		"tags": schema.MapAttribute{
+			Description: "Map of tags to use for the assumed role session",
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"transitive_tag_keys": schema.ListAttribute{
+			Description: "List of tag keys to pass to the assumed role session",
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+	}
+}
+
+// newAssumeRoleChain converts the assume_role block and the assume_role_chain
+// blocks into the roles to assume in order, along with the block path of
+// every hop. An assume_role block without role_arn is skipped, as it always
+// has been, while every assume_role_chain block must name its role.
+func newAssumeRoleChain(ctx context.Context, config *controltowermanagementProviderModel) ([]*client.AssumeRoleConfig, []path.Path, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var assumeRoleConfigs []*client.AssumeRoleConfig
+	var blockPaths []path.Path
+
+	if config.AssumeRole != nil && config.AssumeRole.RoleArn.ValueString() != "" {
+		assumeRoleConfig, d := newAssumeRoleConfig(ctx, config.AssumeRole)
+		diags.Append(d...)
+		assumeRoleConfigs = append(assumeRoleConfigs, assumeRoleConfig)
+		blockPaths = append(blockPaths, path.Root("assume_role"))
+	}
+
+	for i := range config.AssumeRoleChain {
+		blockPath := path.Root("assume_role_chain").AtListIndex(i)
+		if config.AssumeRoleChain[i].RoleArn.ValueString() == "" {
+			diags.AddAttributeError(
+				blockPath.AtName("role_arn"),
+				"Missing Role ARN",
+				"role_arn must be set in every assume_role_chain block",
+			)
+			continue
+		}
+
+		assumeRoleConfig, d := newAssumeRoleConfig(ctx, &config.AssumeRoleChain[i])
+		diags.Append(d...)
+		assumeRoleConfigs = append(assumeRoleConfigs, assumeRoleConfig)
+		blockPaths = append(blockPaths, blockPath)
+	}
+
+	return assumeRoleConfigs, blockPaths, diags
+}
+
+// newAssumeRoleConfig converts an assume_role or assume_role_chain block into
+// the client configuration
+func newAssumeRoleConfig(ctx context.Context, model *assumeRoleModel) (*client.AssumeRoleConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	assumeRoleConfig := &client.AssumeRoleConfig{
+		RoleArn:         model.RoleArn.ValueString(),
+		SessionName:     model.SessionName.ValueString(),
+		ExternalId:      model.ExternalId.ValueString(),
+		DurationSeconds: int32(model.DurationSeconds.ValueInt64()),
+		Policy:          model.Policy.ValueString(),
+	}
+
+	var d diag.Diagnostics
+	assumeRoleConfig.PolicyArns, d = stringListValue(ctx, model.PolicyArns)
+	diags.Append(d...)
+	assumeRoleConfig.Tags, d = stringMapValue(ctx, model.Tags)
+	diags.Append(d...)
+	assumeRoleConfig.TransitiveTagKeys, d = stringListValue(ctx, model.TransitiveTagKeys)
+	diags.Append(d...)
+
+	return assumeRoleConfig, diags
+}
+
+// newWebIdentityConfig validates the assume_role_with_web_identity block and
+// converts it into the client configuration
+func newWebIdentityConfig(model *webIdentityModel) (*client.WebIdentityConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if model.RoleArn.ValueString() == "" {
+		diags.AddAttributeError(
+			path.Root("assume_role_with_web_identity").AtName("role_arn"),
+			"Missing Role ARN",
+			"role_arn must be set in the assume_role_with_web_identity block",
+		)
+	}
+	if model.WebIdentityToken.ValueString() == "" && model.WebIdentityTokenFile.ValueString() == "" {
+		diags.AddAttributeError(
+			path.Root("assume_role_with_web_identity"),
+			"Missing Web Identity Token",
+			"Either web_identity_token or web_identity_token_file must be set in the assume_role_with_web_identity block",
+		)
+	}
+
+	return &client.WebIdentityConfig{
+		RoleArn:              model.RoleArn.ValueString(),
+		SessionName:          model.SessionName.ValueString(),
+		WebIdentityToken:     model.WebIdentityToken.ValueString(),
+		WebIdentityTokenFile: model.WebIdentityTokenFile.ValueString(),
+		DurationSeconds:      int32(model.DurationSeconds.ValueInt64()),
+	}, diags
+}
+
 func (p *controltowermanagementProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewAwsAccountDataSource,
+		NewOrganizationalUnitsDataSource,
+		NewAccountDataSource,
 	}
 }
 
 func (p *controltowermanagementProvider) Resources(ctx context.Context) []func() resource.Resource {
-	return []func() resource.Resource{}
+	return []func() resource.Resource{
+		NewLandingZoneResource,
+		NewEnabledControlResource,
+		NewEnabledBaselineResource,
+		NewAccountResource,
+		NewOrganizationalUnitResource,
+	}
+}
+
+// endpointAttribute returns the schema of a custom endpoint URL for service
+func endpointAttribute(service string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "Custom endpoint URL for " + service,
+		Optional:    true,
+		Validators: []validator.String{
+			validators.RegexMatches(
+				regexp.MustCompile(`^https?://`),
+				"Endpoint must be an http or https URL",
+			),
+		},
+	}
+}
+
+// rateLimitAttribute returns the schema of the request rate limit for service
+func rateLimitAttribute(service string) schema.Float64Attribute {
+	return schema.Float64Attribute{
+		Description: "Maximum requests per second sent to " + service,
+		Optional:    true,
+		Validators: []validator.Float64{
+			float64validator.AtLeast(0.1),
+		},
+	}
+}
+
+// accountIdValidator ensures a string is a 12-digit AWS account ID
+func accountIdValidator() validator.String {
+	return validators.RegexMatches(
+		regexp.MustCompile(`^\d{12}$`),
+		"must be a 12-digit AWS account ID",
+	)
 }
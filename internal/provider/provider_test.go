@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testAccPreCheckEnv skips the test unless all of the given environment
+// variables are set
+func testAccPreCheckEnv(t *testing.T, names ...string) {
+	for _, name := range names {
+		if os.Getenv(name) == "" {
+			t.Skipf("%s must be set for this acceptance test", name)
+		}
+	}
+}
+
+// testAccProviderConfig returns the provider block shared by acceptance tests
+func testAccProviderConfig() string {
+	return `
+provider "controltowermanagement" {
+  access_key = "` + os.Getenv("AWS_ACCESS_KEY") + `"
+  secret_key = "` + os.Getenv("AWS_SECRET_ACCESS_KEY") + `"
+  region     = "` + os.Getenv("AWS_REGION") + `"
+}
+`
+}
+
+func TestProviderSchemaAssumeRoleBlocks(t *testing.T) {
+	resp := &provider.SchemaResponse{}
+	New("test")().Schema(context.Background(), provider.SchemaRequest{}, resp)
+	require.False(t, resp.Diagnostics.HasError())
+
+	// Existing configurations use a single assume_role block
+	assert.IsType(t, schema.SingleNestedBlock{}, resp.Schema.Blocks["assume_role"])
+	assert.IsType(t, schema.ListNestedBlock{}, resp.Schema.Blocks["assume_role_chain"])
+}
+
+func TestNewAssumeRoleChain(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("single assume_role block", func(t *testing.T) {
+		config := &controltowermanagementProviderModel{
+			AssumeRole: &assumeRoleModel{
+				RoleArn:     types.StringValue("arn:aws:iam::111111111111:role/broker"),
+				SessionName: types.StringValue("terraform"),
+			},
+		}
+
+		assumeRoleConfigs, blockPaths, diags := newAssumeRoleChain(ctx, config)
+		require.False(t, diags.HasError())
+		require.Len(t, assumeRoleConfigs, 1)
+		assert.Equal(t, "arn:aws:iam::111111111111:role/broker", assumeRoleConfigs[0].RoleArn)
+		assert.Equal(t, "terraform", assumeRoleConfigs[0].SessionName)
+		assert.Equal(t, []path.Path{path.Root("assume_role")}, blockPaths)
+	})
+
+	t.Run("assume_role without role_arn is skipped", func(t *testing.T) {
+		config := &controltowermanagementProviderModel{
+			AssumeRole: &assumeRoleModel{RoleArn: types.StringNull()},
+		}
+
+		assumeRoleConfigs, blockPaths, diags := newAssumeRoleChain(ctx, config)
+		require.False(t, diags.HasError())
+		assert.Empty(t, assumeRoleConfigs)
+		assert.Empty(t, blockPaths)
+	})
+
+	t.Run("assume_role_chain follows assume_role", func(t *testing.T) {
+		config := &controltowermanagementProviderModel{
+			AssumeRole: &assumeRoleModel{RoleArn: types.StringValue("arn:aws:iam::111111111111:role/broker")},
+			AssumeRoleChain: []assumeRoleModel{
+				{RoleArn: types.StringValue("arn:aws:iam::222222222222:role/AWSControlTowerExecution")},
+			},
+		}
+
+		assumeRoleConfigs, blockPaths, diags := newAssumeRoleChain(ctx, config)
+		require.False(t, diags.HasError())
+		require.Len(t, assumeRoleConfigs, 2)
+		assert.Equal(t, "arn:aws:iam::111111111111:role/broker", assumeRoleConfigs[0].RoleArn)
+		assert.Equal(t, "arn:aws:iam::222222222222:role/AWSControlTowerExecution", assumeRoleConfigs[1].RoleArn)
+		assert.Equal(t, []path.Path{path.Root("assume_role"), path.Root("assume_role_chain").AtListIndex(0)}, blockPaths)
+	})
+
+	t.Run("assume_role_chain requires role_arn", func(t *testing.T) {
+		config := &controltowermanagementProviderModel{
+			AssumeRoleChain: []assumeRoleModel{
+				{RoleArn: types.StringValue("arn:aws:iam::111111111111:role/broker")},
+				{RoleArn: types.StringNull()},
+			},
+		}
+
+		_, _, diags := newAssumeRoleChain(ctx, config)
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Missing Role ARN", diags.Errors()[0].Summary())
+	})
+}
@@ -21,10 +21,52 @@ type assumeRoleModel struct {
 	TransitiveTagKeys types.List   `tfsdk:"transitive_tag_keys"`
 }
 
+// webIdentityModel represents the assume role with web identity configuration
+type webIdentityModel struct {
+	RoleArn              types.String `tfsdk:"role_arn"`
+	SessionName          types.String `tfsdk:"session_name"`
+	WebIdentityToken     types.String `tfsdk:"web_identity_token"`
+	WebIdentityTokenFile types.String `tfsdk:"web_identity_token_file"`
+	DurationSeconds      types.Int64  `tfsdk:"duration_seconds"`
+}
+
+// endpointsModel represents the custom service endpoints configuration
+type endpointsModel struct {
+	Organizations  types.String `tfsdk:"organizations"`
+	STS            types.String `tfsdk:"sts"`
+	ControlTower   types.String `tfsdk:"controltower"`
+	ServiceCatalog types.String `tfsdk:"servicecatalog"`
+	CloudFormation types.String `tfsdk:"cloudformation"`
+	SSO            types.String `tfsdk:"sso"`
+}
+
+// rateLimitsModel represents the per-service request rate limits
+type rateLimitsModel struct {
+	Organizations  types.Float64 `tfsdk:"organizations"`
+	STS            types.Float64 `tfsdk:"sts"`
+	ControlTower   types.Float64 `tfsdk:"controltower"`
+	ServiceCatalog types.Float64 `tfsdk:"servicecatalog"`
+	CloudFormation types.Float64 `tfsdk:"cloudformation"`
+}
+
 // controltowermanagementProviderModel describes the provider data model.
 type controltowermanagementProviderModel struct {
-	AccessKey  types.String     `tfsdk:"access_key"`
-	SecretKey  types.String     `tfsdk:"secret_key"`
-	Region     types.String     `tfsdk:"region"`
-	AssumeRole *assumeRoleModel `tfsdk:"assume_role"`
+	AccessKey                 types.String      `tfsdk:"access_key"`
+	SecretKey                 types.String      `tfsdk:"secret_key"`
+	Region                    types.String      `tfsdk:"region"`
+	Profile                   types.String      `tfsdk:"profile"`
+	SharedConfigFiles         types.List        `tfsdk:"shared_config_files"`
+	SharedCredentialsFiles    types.List        `tfsdk:"shared_credentials_files"`
+	CredentialProcess         types.String      `tfsdk:"credential_process"`
+	AllowedAccountIds         types.Set         `tfsdk:"allowed_account_ids"`
+	ForbiddenAccountIds       types.Set         `tfsdk:"forbidden_account_ids"`
+	RequireManagementAccount  types.Bool        `tfsdk:"require_management_account"`
+	MaxRetries                types.Int64       `tfsdk:"max_retries"`
+	RetryMode                 types.String      `tfsdk:"retry_mode"`
+	MaxBackoffSeconds         types.Int64       `tfsdk:"max_backoff_seconds"`
+	AssumeRole                *assumeRoleModel  `tfsdk:"assume_role"`
+	AssumeRoleChain           []assumeRoleModel `tfsdk:"assume_role_chain"`
+	AssumeRoleWithWebIdentity *webIdentityModel `tfsdk:"assume_role_with_web_identity"`
+	Endpoints                 *endpointsModel   `tfsdk:"endpoints"`
+	RateLimits                *rateLimitsModel  `tfsdk:"rate_limits"`
 }
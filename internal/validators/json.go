@@ -0,0 +1,40 @@
+package validators
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// JSONDocument returns a validator that ensures a string is a valid JSON document
+func JSONDocument() validator.String {
+	return jsonDocumentValidator{}
+}
+
+// jsonDocumentValidator validates that a string is a valid JSON document
+type jsonDocumentValidator struct{}
+
+func (v jsonDocumentValidator) Description(_ context.Context) string {
+	return "value must be a valid JSON document"
+}
+
+func (v jsonDocumentValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jsonDocumentValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var doc interface{}
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), &doc); err != nil {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path,
+			v.Description(ctx),
+			err.Error(),
+		))
+	}
+}
@@ -1,8 +1,10 @@
 package validators
 
 import (
+	"context"
 	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
@@ -11,3 +13,33 @@ import (
 func RegexMatches(pattern *regexp.Regexp, message string) validator.String {
 	return stringvalidator.RegexMatches(pattern, message)
 }
+
+// ValidRegex returns a validator that ensures a string is a valid regular expression
+func ValidRegex() validator.String {
+	return validRegexValidator{}
+}
+
+// validRegexValidator validates that a string compiles as a regular expression
+type validRegexValidator struct{}
+
+func (v validRegexValidator) Description(_ context.Context) string {
+	return "value must be a valid regular expression"
+}
+
+func (v validRegexValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v validRegexValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path,
+			v.Description(ctx),
+			err.Error(),
+		))
+	}
+}